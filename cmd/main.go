@@ -1,6 +1,8 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -15,6 +17,11 @@ func main() {
 	log.SetOutput(os.Stderr)
 	log.SetFlags(0)
 
+	// Subcommands run once and exit instead of serving MCP
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		os.Exit(runReport(os.Args[2:]))
+	}
+
 	// Create PoService (will be passed to tools as needed)
 	poService := &service.PoService{}
 
@@ -35,3 +42,43 @@ func main() {
 
 	log.Println("Shutting down...")
 }
+
+// runReport writes the translation report of a directory to stdout or a file
+func runReport(args []string) int {
+	flags := flag.NewFlagSet("report", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: i18n-mcp report [flags] <directory>")
+		flags.PrintDefaults()
+	}
+	format := flags.String("format", service.ReportMarkdown, "report format: markdown, html or json")
+	output := flags.String("o", "", "write the report to this file instead of stdout")
+	oldest := flags.Int("oldest", 10, "number of oldest untranslated entries to list")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	report, err := service.BuildReport(flags.Arg(0), *oldest)
+	if err != nil {
+		log.Printf("Error scanning for PO files: %v", err)
+		return 1
+	}
+	content, err := report.Render(*format)
+	if err != nil {
+		log.Printf("Error rendering report: %v", err)
+		return 1
+	}
+
+	if *output == "" {
+		fmt.Print(content)
+		return 0
+	}
+	if err := os.WriteFile(*output, []byte(content), 0644); err != nil {
+		log.Printf("Error writing report: %v", err)
+		return 1
+	}
+	return 0
+}
@@ -8,6 +8,7 @@ require (
 	github.com/leonelquinteros/gotext v1.7.0
 	github.com/mark3labs/mcp-go v0.38.0
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/text v0.21.0
 )
 
 require (
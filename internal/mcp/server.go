@@ -44,6 +44,96 @@ func (s *MCPServer) InitializeTools() {
 	translateTool, translateHandler := tools.NewTranslateTool()
 	srv.AddTool(translateTool, translateHandler)
 
+	// 5. Extract strings tool
+	extractStringsTool, extractStringsHandler := tools.NewExtractStringsTool()
+	srv.AddTool(extractStringsTool, extractStringsHandler)
+
+	// 6. Check key usage tool
+	checkKeyUsageTool, checkKeyUsageHandler := tools.NewCheckKeyUsageTool()
+	srv.AddTool(checkKeyUsageTool, checkKeyUsageHandler)
+
+	// 7. Show usage tool
+	showUsageTool, showUsageHandler := tools.NewShowUsageTool()
+	srv.AddTool(showUsageTool, showUsageHandler)
+
+	// 8. Validate translations tool
+	validateTool, validateHandler := tools.NewValidateTranslationsTool()
+	srv.AddTool(validateTool, validateHandler)
+
+	// 9. Check plural forms tool
+	checkPluralFormsTool, checkPluralFormsHandler := tools.NewCheckPluralFormsTool()
+	srv.AddTool(checkPluralFormsTool, checkPluralFormsHandler)
+
+	// 10. Glossary tools
+	addGlossaryTermTool, addGlossaryTermHandler := tools.NewAddGlossaryTermTool()
+	srv.AddTool(addGlossaryTermTool, addGlossaryTermHandler)
+
+	listGlossaryTermsTool, listGlossaryTermsHandler := tools.NewListGlossaryTermsTool()
+	srv.AddTool(listGlossaryTermsTool, listGlossaryTermsHandler)
+
+	deleteGlossaryTermTool, deleteGlossaryTermHandler := tools.NewDeleteGlossaryTermTool()
+	srv.AddTool(deleteGlossaryTermTool, deleteGlossaryTermHandler)
+
+	// 11. Check consistency tool
+	checkConsistencyTool, checkConsistencyHandler := tools.NewCheckConsistencyTool()
+	srv.AddTool(checkConsistencyTool, checkConsistencyHandler)
+
+	// 12. Lint translations tool
+	lintTool, lintHandler := tools.NewLintTranslationsTool()
+	srv.AddTool(lintTool, lintHandler)
+
+	// 13. Convert to UTF-8 tool
+	convertToUTF8Tool, convertToUTF8Handler := tools.NewConvertToUTF8Tool()
+	srv.AddTool(convertToUTF8Tool, convertToUTF8Handler)
+
+	// 14. Suggest translations tool
+	suggestTranslationsTool, suggestTranslationsHandler := tools.NewSuggestTranslationsTool()
+	srv.AddTool(suggestTranslationsTool, suggestTranslationsHandler)
+
+	// 15. Look up all languages tool
+	lookUpAllLanguagesTool, lookUpAllLanguagesHandler := tools.NewLookUpAllLanguagesTool()
+	srv.AddTool(lookUpAllLanguagesTool, lookUpAllLanguagesHandler)
+
+	// 16. Batch translate tool
+	batchTranslateTool, batchTranslateHandler := tools.NewBatchTranslateTool()
+	srv.AddTool(batchTranslateTool, batchTranslateHandler)
+
+	// 17. List changes tool
+	listChangesTool, listChangesHandler := tools.NewListChangesTool()
+	srv.AddTool(listChangesTool, listChangesHandler)
+
+	// 18. Revert changes tool
+	revertChangesTool, revertChangesHandler := tools.NewRevertChangesTool()
+	srv.AddTool(revertChangesTool, revertChangesHandler)
+
+	// 19. List pending reviews tool
+	listPendingReviewsTool, listPendingReviewsHandler := tools.NewListPendingReviewsTool()
+	srv.AddTool(listPendingReviewsTool, listPendingReviewsHandler)
+
+	// 20. Review translations tool
+	reviewTranslationsTool, reviewTranslationsHandler := tools.NewReviewTranslationsTool()
+	srv.AddTool(reviewTranslationsTool, reviewTranslationsHandler)
+
+	// 21. Get statistics tool
+	getStatisticsTool, getStatisticsHandler := tools.NewGetStatisticsTool()
+	srv.AddTool(getStatisticsTool, getStatisticsHandler)
+
+	// 22. Generate report tool
+	generateReportTool, generateReportHandler := tools.NewGenerateReportTool()
+	srv.AddTool(generateReportTool, generateReportHandler)
+
+	// 23. Add language tool
+	addLanguageTool, addLanguageHandler := tools.NewAddLanguageTool()
+	srv.AddTool(addLanguageTool, addLanguageHandler)
+
+	// 24. Rename key tool
+	renameKeyTool, renameKeyHandler := tools.NewRenameKeyTool()
+	srv.AddTool(renameKeyTool, renameKeyHandler)
+
+	// 25. Delete key tool
+	deleteKeyTool, deleteKeyHandler := tools.NewDeleteKeyTool()
+	srv.AddTool(deleteKeyTool, deleteKeyHandler)
+
 	s.server = srv
 }
 
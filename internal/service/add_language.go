@@ -0,0 +1,95 @@
+package service
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// NewLanguageCatalog turns a template, a .pot file or the catalog of another language,
+// into an empty catalog of language. The headers name the language, its plural forms
+// from the embedded rules and UTF-8; every msgstr is emptied and the translator
+// comments, review state and obsolete entries of the template are dropped.
+func NewLanguageCatalog(template *utils.Catalog, language string) (*utils.Catalog, error) {
+	plural, ok := utils.LookupPluralForms(language)
+	if !ok {
+		return nil, fmt.Errorf("no plural rules known for language %q", language)
+	}
+
+	if template.Header != nil {
+		template.Header.RemoveFlag("fuzzy")
+	}
+	template.SetHeaderValue("PO-Revision-Date", time.Now().Format("2006-01-02 15:04-0700"))
+	template.SetHeaderValue("Language", utils.GettextLocale(language))
+	template.SetHeaderValue("MIME-Version", "1.0")
+	template.SetCharset("UTF-8")
+	template.SetHeaderValue("Content-Transfer-Encoding", "8bit")
+	template.SetHeaderValue("Plural-Forms", plural.String())
+
+	template.Entries = slices.DeleteFunc(template.Entries, func(entry *utils.CatalogEntry) bool {
+		return entry.Obsolete
+	})
+	for _, entry := range template.Entries {
+		if entry.PluralID != "" {
+			entry.Str = make([]string, plural.NPlurals)
+		} else {
+			entry.Str = []string{""}
+		}
+		entry.RemoveFlag("fuzzy")
+		entry.RemoveFlagValue(reviewFlag)
+		entry.TranslatorComments = nil
+		entry.PreviousContext, entry.PreviousID, entry.PreviousPluralID = "", "", ""
+	}
+	return template, nil
+}
+
+// LanguageCatalogPath returns where the catalog of language created from a template
+// belongs. A catalog of another language is followed by the same layout; for a .pot
+// file the layout of the existing catalogs of the same domain in files is used, and
+// <language>.po next to the template when there is none.
+func LanguageCatalogPath(templatePath, language string, files []utils.PoFileInfo) string {
+	domain := ""
+	if utils.LanguageFromPath(templatePath) != "" {
+		domain = utils.CatalogDomain(templatePath)
+	} else {
+		domain = templateDomain(templatePath, files)
+	}
+	if domain == "" {
+		return filepath.Join(filepath.Dir(templatePath), utils.GettextLocale(language)+".po")
+	}
+
+	// Keep pt-BR when the existing catalogs are named with BCP 47 tags
+	for _, file := range files {
+		if strings.Contains(file.Language, "-") && utils.CatalogDomain(file.Path) == domain &&
+			strings.Contains(file.Path, file.Language) {
+			return utils.DomainPath(domain, utils.NormalizeLanguageTag(language))
+		}
+	}
+	return utils.DomainPath(domain, utils.GettextLocale(language))
+}
+
+// templateDomain finds the domain of the catalogs created from a .pot file: catalogs
+// named after the template, such as locale/fr/LC_MESSAGES/app.po or app.fr.po for
+// app.pot, or else catalogs named after their language in the directory of the template
+func templateDomain(templatePath string, files []utils.PoFileInfo) string {
+	stem := strings.TrimSuffix(filepath.Base(templatePath), filepath.Ext(templatePath))
+	sameDirectory := ""
+	for _, file := range files {
+		domain := utils.CatalogDomain(file.Path)
+		if file.PathLanguage == "" || domain == file.Path {
+			continue
+		}
+		domainStem := strings.TrimSuffix(filepath.Base(domain), filepath.Ext(domain))
+		if domainStem == stem || strings.TrimSuffix(domainStem, "."+utils.LanguagePlaceholder) == stem {
+			return domain
+		}
+		if sameDirectory == "" && domainStem == utils.LanguagePlaceholder && filepath.Dir(domain) == filepath.Dir(templatePath) {
+			sameDirectory = domain
+		}
+	}
+	return sameDirectory
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLanguageCatalog(t *testing.T) {
+	template, err := utils.ParseCatalog([]byte(`#, fuzzy
+msgid ""
+msgstr ""
+"Project-Id-Version: app 1.0\n"
+"Language: fr\n"
+"Content-Type: text/plain; charset=ISO-8859-1\n"
+
+# provenance: ai
+#. Button label
+#: src/app.js:3
+#, fuzzy, c-format, review:draft
+#| msgid "Save all"
+msgid "Save"
+msgstr "Enregistrer"
+
+msgid "One file"
+msgid_plural "%d files"
+msgstr[0] "Un fichier"
+msgstr[1] "%d fichiers"
+
+#~ msgid "Old"
+#~ msgstr "Vieux"
+`))
+	require.NoError(t, err)
+
+	catalog, err := NewLanguageCatalog(template, "pl")
+	require.NoError(t, err)
+	assert.False(t, catalog.Header.IsFuzzy())
+	assert.Equal(t, "pl", catalog.Language())
+	assert.Equal(t, "app 1.0", catalog.HeaderValue("Project-Id-Version"))
+	assert.Equal(t, "text/plain; charset=UTF-8", catalog.HeaderValue("Content-Type"))
+	polish, _ := utils.LookupPluralForms("pl")
+	assert.Equal(t, polish.String(), catalog.HeaderValue("Plural-Forms"))
+
+	require.Len(t, catalog.Entries, 2)
+	save := catalog.Lookup("", "Save")
+	assert.Equal(t, []string{""}, save.Str)
+	assert.Equal(t, []string{"c-format"}, save.Flags)
+	assert.Empty(t, save.TranslatorComments)
+	assert.Equal(t, []string{"Button label"}, save.ExtractedComments)
+	assert.Equal(t, "", save.PreviousID)
+	assert.Equal(t, []string{"", "", ""}, catalog.Lookup("", "One file").Str)
+
+	t.Run("Unknown Language", func(t *testing.T) {
+		_, err := NewLanguageCatalog(utils.NewCatalog(), "xx")
+		assert.Error(t, err)
+	})
+}
+
+func TestLanguageCatalogPath(t *testing.T) {
+	scan := func(paths ...string) []utils.PoFileInfo {
+		var files []utils.PoFileInfo
+		for _, path := range paths {
+			files = append(files, utils.NewPoFileInfo(filepath.FromSlash(path), ""))
+		}
+		return files
+	}
+	tests := []struct {
+		name     string
+		template string
+		files    []utils.PoFileInfo
+		want     string
+	}{
+		{"Gettext Layout", "locale/app.pot", scan("locale/fr/LC_MESSAGES/app.po", "locale/fr/LC_MESSAGES/admin.po"), "locale/pt_BR/LC_MESSAGES/app.po"},
+		{"Domain In File Name", "i18n/app.pot", scan("i18n/app.de.po"), "i18n/app.pt_BR.po"},
+		{"Language Files Next To Template", "po/messages.pot", scan("po/de.po", "other/fr.po"), "po/pt_BR.po"},
+		{"BCP 47 Names", "i18n/app.pot", scan("i18n/app.de.po", "i18n/app.zh-TW.po"), "i18n/app.pt-BR.po"},
+		{"Other Language As Template", "locale/fr/LC_MESSAGES/app.po", nil, "locale/pt_BR/LC_MESSAGES/app.po"},
+		{"No Existing Catalogs", "templates/app.pot", nil, "templates/pt_BR.po"},
+		{"Language Named Directory", "src/ts/locale/app.pot", scan("src/ts/locale/de.po"), "src/ts/locale/pt_BR.po"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, filepath.FromSlash(tt.want), LanguageCatalogPath(filepath.FromSlash(tt.template), "pt-BR", tt.files))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// CatalogFile is a parsed catalog together with the path it was read from
+type CatalogFile struct {
+	Path     string
+	Language string
+	Catalog  *utils.Catalog
+}
+
+// TranslationVariant is one of the translations used for a msgid and the files using it
+type TranslationVariant struct {
+	MsgStr []string `json:"msgstr"`
+	Files  []string `json:"files"`
+}
+
+// DivergentTranslation is a msgid translated differently across the files of a language
+type DivergentTranslation struct {
+	Context  string               `json:"context,omitempty"`
+	MsgID    string               `json:"msgid"`
+	Variants []TranslationVariant `json:"variants"`
+}
+
+// SharedSource is a msgid using a translation shared with other msgids
+type SharedSource struct {
+	Context string   `json:"context,omitempty"`
+	MsgID   string   `json:"msgid"`
+	Files   []string `json:"files"`
+}
+
+// SharedTranslation is a msgstr used for several distinct msgids
+type SharedTranslation struct {
+	MsgStr  string         `json:"msgstr"`
+	Sources []SharedSource `json:"sources"`
+}
+
+// ConsistencyReport lists the inconsistencies between the files of one language
+type ConsistencyReport struct {
+	Language           string                 `json:"language"`
+	Files              []string               `json:"files"`
+	Divergent          []DivergentTranslation `json:"divergent"`
+	SharedTranslations []SharedTranslation    `json:"shared_translations"`
+}
+
+// CheckConsistency compares the translated, non-fuzzy entries of files with the same
+// language. It reports msgids with more than one translation and distinct msgids
+// sharing an identical translation.
+func CheckConsistency(language string, files []CatalogFile) ConsistencyReport {
+	report := ConsistencyReport{
+		Language:           language,
+		Files:              []string{},
+		Divergent:          []DivergentTranslation{},
+		SharedTranslations: []SharedTranslation{},
+	}
+
+	type keyInfo struct {
+		context, msgid string
+		variants       map[string]*TranslationVariant
+		order          []string
+	}
+	byKey := make(map[string]*keyInfo)
+	var keys []string
+	// shared maps a singular msgstr to the keys using it
+	shared := make(map[string][]string)
+
+	for _, file := range files {
+		report.Files = append(report.Files, file.Path)
+		for _, entry := range file.Catalog.ActiveEntries() {
+			if !entry.IsTranslated() || entry.IsFuzzy() {
+				continue
+			}
+			key := entry.Key()
+			info, ok := byKey[key]
+			if !ok {
+				info = &keyInfo{context: entry.Context, msgid: entry.ID, variants: make(map[string]*TranslationVariant)}
+				byKey[key] = info
+				keys = append(keys, key)
+			}
+			variantKey := strings.Join(entry.Str, "\x00")
+			variant, ok := info.variants[variantKey]
+			if !ok {
+				variant = &TranslationVariant{MsgStr: entry.Str}
+				info.variants[variantKey] = variant
+				info.order = append(info.order, variantKey)
+			}
+			variant.Files = appendUnique(variant.Files, file.Path)
+
+			if entry.PluralID == "" {
+				shared[entry.Translation()] = appendUnique(shared[entry.Translation()], key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		info := byKey[key]
+		if len(info.variants) < 2 {
+			continue
+		}
+		divergent := DivergentTranslation{Context: info.context, MsgID: info.msgid}
+		for _, variantKey := range info.order {
+			divergent.Variants = append(divergent.Variants, *info.variants[variantKey])
+		}
+		// Most used translation first
+		sort.SliceStable(divergent.Variants, func(i, j int) bool {
+			return len(divergent.Variants[i].Files) > len(divergent.Variants[j].Files)
+		})
+		report.Divergent = append(report.Divergent, divergent)
+	}
+
+	msgstrs := make([]string, 0, len(shared))
+	for msgstr, sharedKeys := range shared {
+		if len(sharedKeys) > 1 {
+			msgstrs = append(msgstrs, msgstr)
+		}
+	}
+	sort.Strings(msgstrs)
+	for _, msgstr := range msgstrs {
+		sharedTranslation := SharedTranslation{MsgStr: msgstr}
+		for _, key := range shared[msgstr] {
+			info := byKey[key]
+			var sourceFiles []string
+			for _, variant := range info.variants {
+				if variant.MsgStr[0] == msgstr {
+					sourceFiles = append(sourceFiles, variant.Files...)
+				}
+			}
+			sort.Strings(sourceFiles)
+			sharedTranslation.Sources = append(sharedTranslation.Sources, SharedSource{
+				Context: info.context,
+				MsgID:   info.msgid,
+				Files:   sourceFiles,
+			})
+		}
+		report.SharedTranslations = append(report.SharedTranslations, sharedTranslation)
+	}
+
+	return report
+}
+
+// PropagateTranslation sets the translation of a singular msgid in every file containing it.
+// It returns the paths of the files whose translation changed.
+func PropagateTranslation(files []CatalogFile, context, msgid, translation string) []string {
+	changed := []string{}
+	for _, file := range files {
+		entry := file.Catalog.Lookup(context, msgid)
+		if entry == nil || entry.PluralID != "" || (entry.Translation() == translation && !entry.IsFuzzy()) {
+			continue
+		}
+		entry.SetTranslation(translation)
+		entry.RemoveFlag("fuzzy")
+		changed = append(changed, file.Path)
+	}
+	return changed
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
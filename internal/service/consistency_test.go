@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTestCatalogFile(t *testing.T, path, content string) CatalogFile {
+	catalog, err := utils.ParseCatalog([]byte(content))
+	require.NoError(t, err)
+	return CatalogFile{Path: path, Catalog: catalog}
+}
+
+func TestCheckConsistency(t *testing.T) {
+	files := []CatalogFile{
+		parseTestCatalogFile(t, "admin.po", `msgid "Save"
+msgstr "Speichern"
+
+msgid "Delete"
+msgstr "Löschen"
+
+msgid "Remove"
+msgstr "Löschen"
+`),
+		parseTestCatalogFile(t, "web.po", `msgid "Save"
+msgstr "Sichern"
+
+msgid "Delete"
+msgstr "Löschen"
+
+#, fuzzy
+msgid "Cancel"
+msgstr "Abbruch"
+`),
+		parseTestCatalogFile(t, "email.po", `msgid "Save"
+msgstr "Speichern"
+
+msgid "Cancel"
+msgstr "Abbrechen"
+`),
+	}
+
+	report := CheckConsistency("de", files)
+	assert.Equal(t, []string{"admin.po", "web.po", "email.po"}, report.Files)
+
+	require.Len(t, report.Divergent, 1)
+	assert.Equal(t, "Save", report.Divergent[0].MsgID)
+	require.Len(t, report.Divergent[0].Variants, 2)
+	assert.Equal(t, []string{"Speichern"}, report.Divergent[0].Variants[0].MsgStr)
+	assert.Equal(t, []string{"admin.po", "email.po"}, report.Divergent[0].Variants[0].Files)
+
+	require.Len(t, report.SharedTranslations, 1)
+	assert.Equal(t, "Löschen", report.SharedTranslations[0].MsgStr)
+	require.Len(t, report.SharedTranslations[0].Sources, 2)
+	assert.Equal(t, "Delete", report.SharedTranslations[0].Sources[0].MsgID)
+	assert.Equal(t, []string{"admin.po", "web.po"}, report.SharedTranslations[0].Sources[0].Files)
+	assert.Equal(t, "Remove", report.SharedTranslations[0].Sources[1].MsgID)
+}
+
+func TestPropagateTranslation(t *testing.T) {
+	files := []CatalogFile{
+		parseTestCatalogFile(t, "admin.po", "msgid \"Save\"\nmsgstr \"Speichern\"\n"),
+		parseTestCatalogFile(t, "web.po", "#, fuzzy\nmsgid \"Save\"\nmsgstr \"Sichern\"\n"),
+		parseTestCatalogFile(t, "email.po", "msgid \"Cancel\"\nmsgstr \"Abbrechen\"\n"),
+	}
+
+	changed := PropagateTranslation(files, "", "Save", "Speichern")
+	assert.Equal(t, []string{"web.po"}, changed)
+	assert.Equal(t, "Speichern", files[1].Catalog.Lookup("", "Save").Translation())
+	assert.False(t, files[1].Catalog.Lookup("", "Save").IsFuzzy())
+	assert.Nil(t, files[2].Catalog.Lookup("", "Save"))
+
+	assert.Empty(t, CheckConsistency("de", files).Divergent)
+}
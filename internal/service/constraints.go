@@ -0,0 +1,113 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+const (
+	// ConstraintsFile is the sidecar file in the project config directory declaring length limits
+	ConstraintsFile = "constraints.json"
+
+	// maxLengthFlag is the flag comment declaring the length limit of an entry, as in "#, max-length:20"
+	maxLengthFlag = "max-length"
+)
+
+// LengthRule limits the translation length of the entries it selects. Entries are selected
+// by msgid, context and a glob matched against their "#:" reference paths; empty selectors
+// match everything, but a rule needs at least one selector.
+type LengthRule struct {
+	MsgID     string `json:"msgid,omitempty"`
+	Context   string `json:"context,omitempty"`
+	Reference string `json:"reference,omitempty"`
+	MaxLength int    `json:"max_length"`
+}
+
+// Constraints are the project-wide translation constraints read from the sidecar file
+type Constraints struct {
+	MaxLength []LengthRule `json:"max_length"`
+}
+
+// EntryConstraints are the constraints that apply to a single entry
+type EntryConstraints struct {
+	MaxLength int    `json:"max_length,omitempty"`
+	Source    string `json:"source,omitempty"`
+}
+
+// LoadConstraints reads the constraints of the project. A project without a
+// constraints file has no constraints.
+func LoadConstraints(projectRoot string) (*Constraints, error) {
+	constraints := &Constraints{}
+	if err := utils.LoadProjectConfig(projectRoot, ConstraintsFile, constraints); err != nil {
+		return nil, err
+	}
+	return constraints, nil
+}
+
+// ForEntry returns the constraints of an entry. A max-length flag on the entry takes
+// precedence over the sidecar rules; when several rules match the smallest limit wins.
+func (c *Constraints) ForEntry(entry *utils.CatalogEntry) EntryConstraints {
+	if value, ok := entry.FlagValue(maxLengthFlag); ok {
+		if limit, err := strconv.Atoi(value); err == nil && limit > 0 {
+			return EntryConstraints{MaxLength: limit, Source: "flag"}
+		}
+	}
+
+	result := EntryConstraints{}
+	if c == nil {
+		return result
+	}
+	for _, rule := range c.MaxLength {
+		if rule.MaxLength <= 0 || !rule.matches(entry) {
+			continue
+		}
+		if result.MaxLength == 0 || rule.MaxLength < result.MaxLength {
+			result = EntryConstraints{MaxLength: rule.MaxLength, Source: ConstraintsFile}
+		}
+	}
+	return result
+}
+
+func (r LengthRule) matches(entry *utils.CatalogEntry) bool {
+	if r.MsgID == "" && r.Context == "" && r.Reference == "" {
+		return false
+	}
+	if r.MsgID != "" && r.MsgID != entry.ID {
+		return false
+	}
+	if r.Context != "" && r.Context != entry.Context {
+		return false
+	}
+	if r.Reference == "" {
+		return true
+	}
+	for _, ref := range entry.References {
+		file, _ := utils.SplitReference(ref)
+		if utils.MatchGlob(r.Reference, file) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckLength reports translations longer than the limit of the entry. Length is
+// counted in characters, not bytes.
+func CheckLength(context, msgid, msgstr string, constraints EntryConstraints) []ValidationIssue {
+	issues := []ValidationIssue{}
+	if constraints.MaxLength <= 0 {
+		return issues
+	}
+	if length := utf8.RuneCountInString(msgstr); length > constraints.MaxLength {
+		issues = append(issues, ValidationIssue{
+			Context:  context,
+			MsgID:    msgid,
+			Check:    "max_length",
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("translation is %d characters long, the maximum is %d (from %s)", length, constraints.MaxLength, constraints.Source),
+		})
+	}
+	return issues
+}
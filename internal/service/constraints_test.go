@@ -0,0 +1,77 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintsForEntry(t *testing.T) {
+	constraints := &Constraints{MaxLength: []LengthRule{
+		{Reference: "src/components/buttons/*", MaxLength: 20},
+		{MsgID: "Save", MaxLength: 10},
+		{Context: "push-title", MaxLength: 40},
+		{MaxLength: 5},
+	}}
+
+	t.Run("Flag Takes Precedence", func(t *testing.T) {
+		entry := &utils.CatalogEntry{ID: "Save", Flags: []string{"max-length:15"}}
+		assert.Equal(t, EntryConstraints{MaxLength: 15, Source: "flag"}, constraints.ForEntry(entry))
+	})
+
+	t.Run("Smallest Matching Rule", func(t *testing.T) {
+		entry := &utils.CatalogEntry{ID: "Save", References: []string{"src/components/buttons/Save.tsx:12"}}
+		assert.Equal(t, EntryConstraints{MaxLength: 10, Source: ConstraintsFile}, constraints.ForEntry(entry))
+
+		entry = &utils.CatalogEntry{ID: "Cancel", References: []string{"src/components/buttons/Cancel.tsx:3"}}
+		assert.Equal(t, 20, constraints.ForEntry(entry).MaxLength)
+
+		entry = &utils.CatalogEntry{ID: "New message", Context: "push-title"}
+		assert.Equal(t, 40, constraints.ForEntry(entry).MaxLength)
+	})
+
+	t.Run("No Matching Rule", func(t *testing.T) {
+		entry := &utils.CatalogEntry{ID: "Welcome", References: []string{"src/pages/Home.tsx:3"}}
+		assert.Equal(t, EntryConstraints{}, constraints.ForEntry(entry))
+
+		var none *Constraints
+		assert.Equal(t, EntryConstraints{}, none.ForEntry(entry))
+	})
+}
+
+func TestCheckLength(t *testing.T) {
+	limit := EntryConstraints{MaxLength: 5, Source: "flag"}
+	assert.Empty(t, CheckLength("", "Save", "Enreg", limit))
+	// Characters are counted, not bytes
+	assert.Empty(t, CheckLength("", "Save", "Größe", limit))
+
+	issues := CheckLength("", "Save", "Speichern", limit)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "max_length", issues[0].Check)
+	assert.Equal(t, SeverityError, issues[0].Severity)
+	assert.Contains(t, issues[0].Message, "9 characters")
+
+	assert.Empty(t, CheckLength("", "Save", "Speichern", EntryConstraints{}))
+}
+
+func TestLoadConstraints(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "constraints_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	constraints, err := LoadConstraints(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, constraints.MaxLength)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, utils.ConfigDirName), 0755))
+	config := `{"max_length": [{"reference": "src/buttons/**", "max_length": 20}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, utils.ConfigDirName, ConstraintsFile), []byte(config), 0644))
+
+	constraints, err = LoadConstraints(tempDir)
+	require.NoError(t, err)
+	assert.Equal(t, []LengthRule{{Reference: "src/buttons/**", MaxLength: 20}}, constraints.MaxLength)
+}
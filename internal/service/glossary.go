@@ -0,0 +1,235 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// GlossaryFile is the file in the project config directory holding the glossary
+const GlossaryFile = "glossary.json"
+
+// GlossaryTerm is a product term with its required translation in one language,
+// or a name that must never be translated
+type GlossaryTerm struct {
+	Term           string `json:"term"`
+	Language       string `json:"language,omitempty"`
+	Translation    string `json:"translation,omitempty"`
+	DoNotTranslate bool   `json:"do_not_translate,omitempty"`
+	CaseSensitive  bool   `json:"case_sensitive,omitempty"`
+	Note           string `json:"note,omitempty"`
+}
+
+// Glossary is the terminology of a project
+type Glossary struct {
+	Terms []GlossaryTerm `json:"terms"`
+}
+
+// LoadGlossary reads the glossary of the project. A project without a glossary file
+// has an empty glossary.
+func LoadGlossary(projectRoot string) (*Glossary, error) {
+	glossary := &Glossary{Terms: []GlossaryTerm{}}
+	if err := utils.LoadProjectConfig(projectRoot, GlossaryFile, glossary); err != nil {
+		return nil, err
+	}
+	return glossary, nil
+}
+
+// Save writes the glossary to the project config directory
+func (g *Glossary) Save(projectRoot string) error {
+	g.sortTerms()
+	return utils.SaveProjectConfig(projectRoot, GlossaryFile, g)
+}
+
+// Diff returns the unified diff Save would apply to the glossary file
+func (g *Glossary) Diff(projectRoot string) (string, error) {
+	g.sortTerms()
+	return utils.DiffProjectConfig(projectRoot, GlossaryFile, g)
+}
+
+func (g *Glossary) sortTerms() {
+	sort.SliceStable(g.Terms, func(i, j int) bool {
+		if !strings.EqualFold(g.Terms[i].Term, g.Terms[j].Term) {
+			return strings.ToLower(g.Terms[i].Term) < strings.ToLower(g.Terms[j].Term)
+		}
+		return g.Terms[i].Language < g.Terms[j].Language
+	})
+}
+
+// Add adds a term, replacing an existing term with the same text and language. Terms
+// that are not case sensitive match regardless of case. It reports whether an existing
+// term was replaced.
+func (g *Glossary) Add(term GlossaryTerm) (bool, error) {
+	term.Term = strings.TrimSpace(term.Term)
+	term.Language = normalizeGlossaryLanguage(term.Language)
+	switch {
+	case term.Term == "":
+		return false, fmt.Errorf("term must not be empty")
+	case term.DoNotTranslate && term.Translation != "":
+		return false, fmt.Errorf("a do-not-translate term cannot have a translation")
+	case !term.DoNotTranslate && term.Translation == "":
+		return false, fmt.Errorf("a translation is required unless the term must not be translated")
+	case !term.DoNotTranslate && term.Language == "":
+		return false, fmt.Errorf("a language is required for a translated term")
+	}
+
+	for i, existing := range g.Terms {
+		if sameTerm(existing, term.Term, term.CaseSensitive) && existing.Language == term.Language {
+			g.Terms[i] = term
+			return true, nil
+		}
+	}
+	g.Terms = append(g.Terms, term)
+	return false, nil
+}
+
+// Delete removes a term, matched like in Add. An empty language removes the term in
+// every language. It returns the number of removed terms.
+func (g *Glossary) Delete(term, language string) int {
+	term = strings.TrimSpace(term)
+	language = normalizeGlossaryLanguage(language)
+	kept := g.Terms[:0]
+	removed := 0
+	for _, existing := range g.Terms {
+		if sameTerm(existing, term, false) && (language == "" || existing.Language == language) {
+			removed++
+			continue
+		}
+		kept = append(kept, existing)
+	}
+	g.Terms = kept
+	return removed
+}
+
+// sameTerm reports whether term names the existing term. The text must match exactly
+// when either of them is case sensitive.
+func sameTerm(existing GlossaryTerm, term string, caseSensitive bool) bool {
+	if existing.CaseSensitive || caseSensitive {
+		return existing.Term == term
+	}
+	return strings.EqualFold(existing.Term, term)
+}
+
+// ForLanguage returns the terms that apply to a language: its translated terms,
+// the terms of its base language and the do-not-translate terms of all languages
+func (g *Glossary) ForLanguage(language string) []GlossaryTerm {
+	if language == "" {
+		return g.Terms
+	}
+	terms := []GlossaryTerm{}
+	for _, term := range g.Terms {
+		if glossaryLanguageMatches(term.Language, language) {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// Matches returns the terms of a language that occur in a source text
+func (g *Glossary) Matches(language, source string) []GlossaryTerm {
+	matches := []GlossaryTerm{}
+	for _, term := range g.ForLanguage(language) {
+		if containsWord(source, term.Term, term.CaseSensitive, true) {
+			matches = append(matches, term)
+		}
+	}
+	return matches
+}
+
+// CheckGlossary warns when a translation does not use the glossary translation of a term
+// found in the source, or translates a term that must be kept as is
+func (g *Glossary) CheckGlossary(language, context, msgid, msgstr string) []ValidationIssue {
+	issues := []ValidationIssue{}
+	if msgstr == "" {
+		return issues
+	}
+	for _, term := range g.Matches(language, msgid) {
+		if term.DoNotTranslate {
+			if !containsWord(msgstr, term.Term, true, false) {
+				issues = append(issues, ValidationIssue{
+					Context:  context,
+					MsgID:    msgid,
+					Check:    "do_not_translate",
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("%q must not be translated", term.Term),
+				})
+			}
+			continue
+		}
+		// Only the start of the translation is matched so inflected forms are accepted
+		if !containsWord(msgstr, term.Translation, term.CaseSensitive, false) {
+			issues = append(issues, ValidationIssue{
+				Context:  context,
+				MsgID:    msgid,
+				Check:    "glossary",
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("%q should be translated as %q", term.Term, term.Translation),
+			})
+		}
+	}
+	return issues
+}
+
+// containsWord reports whether word occurs in text at a word boundary. With wholeWord
+// the end must be a boundary too, allowing an English plural "s" or "es".
+func containsWord(text, word string, caseSensitive, wholeWord bool) bool {
+	if word == "" {
+		return false
+	}
+	if !caseSensitive {
+		text = strings.ToLower(text)
+		word = strings.ToLower(word)
+	}
+	for offset := 0; ; {
+		idx := strings.Index(text[offset:], word)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(word)
+		offset = start + 1
+
+		if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+			continue
+		}
+		if !wholeWord {
+			return true
+		}
+		rest := text[end:]
+		for _, suffix := range []string{"es", "s"} {
+			if strings.HasPrefix(strings.ToLower(rest), suffix) {
+				rest = rest[len(suffix):]
+				break
+			}
+		}
+		if after, _ := utf8.DecodeRuneInString(rest); rest == "" || !isWordRune(after) {
+			return true
+		}
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func normalizeGlossaryLanguage(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+}
+
+// glossaryLanguageMatches reports whether a term language applies to a catalog language.
+// Terms without a language apply everywhere and "de" terms apply to "de-CH" catalogs.
+func glossaryLanguageMatches(termLanguage, language string) bool {
+	if termLanguage == "" {
+		return true
+	}
+	language = normalizeGlossaryLanguage(language)
+	if termLanguage == language {
+		return true
+	}
+	primary, _, _ := strings.Cut(language, "-")
+	return !strings.Contains(termLanguage, "-") && termLanguage == primary
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGlossary(t *testing.T) *Glossary {
+	glossary := &Glossary{}
+	for _, term := range []GlossaryTerm{
+		{Term: "Workspace", Language: "de", Translation: "Arbeitsbereich"},
+		{Term: "Seat", Language: "de", Translation: "Lizenz"},
+		{Term: "Workspace", Language: "fr", Translation: "espace de travail"},
+		{Term: "Acme", DoNotTranslate: true},
+	} {
+		_, err := glossary.Add(term)
+		require.NoError(t, err)
+	}
+	return glossary
+}
+
+func TestGlossaryAddDelete(t *testing.T) {
+	glossary := newTestGlossary(t)
+
+	replaced, err := glossary.Add(GlossaryTerm{Term: "Seat", Language: "DE", Translation: "Platz"})
+	require.NoError(t, err)
+	assert.True(t, replaced)
+	assert.Len(t, glossary.Terms, 4)
+
+	_, err = glossary.Add(GlossaryTerm{Term: "Billing Owner", Language: "de"})
+	assert.Error(t, err)
+	_, err = glossary.Add(GlossaryTerm{Term: "Billing Owner", Translation: "Rechnungsinhaber"})
+	assert.Error(t, err)
+	_, err = glossary.Add(GlossaryTerm{Term: "Acme", DoNotTranslate: true, Translation: "Akme"})
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, glossary.Delete("Workspace", "fr"))
+	assert.Equal(t, 0, glossary.Delete("Workspace", "fr"))
+	assert.Equal(t, 1, glossary.Delete("Workspace", ""))
+	assert.Len(t, glossary.Terms, 2)
+
+	// Terms are trimmed and matched regardless of case like in Add
+	replaced, err = glossary.Add(GlossaryTerm{Term: " seat ", Language: "de", Translation: "Sitzplatz"})
+	require.NoError(t, err)
+	assert.True(t, replaced)
+	assert.Equal(t, 1, glossary.Delete(" SEAT ", "de"))
+
+	_, err = glossary.Add(GlossaryTerm{Term: "iOS", DoNotTranslate: true, CaseSensitive: true})
+	require.NoError(t, err)
+	assert.Equal(t, 0, glossary.Delete("ios", ""))
+	assert.Equal(t, 1, glossary.Delete(" iOS", ""))
+	assert.Len(t, glossary.Terms, 1)
+}
+
+func TestGlossaryMatches(t *testing.T) {
+	glossary := newTestGlossary(t)
+
+	assert.Len(t, glossary.ForLanguage("de_CH"), 3)
+	assert.Len(t, glossary.ForLanguage("fr"), 2)
+	assert.Len(t, glossary.ForLanguage(""), 4)
+
+	terms := glossary.Matches("de", "Invite people to your workspaces at Acme")
+	require.Len(t, terms, 2)
+	assert.Equal(t, "Workspace", terms[0].Term)
+	assert.Equal(t, "Acme", terms[1].Term)
+
+	// Words containing a term are not matches
+	assert.Empty(t, glossary.Matches("de", "Seattle office"))
+	assert.Len(t, glossary.Matches("de", "2 seats left"), 1)
+}
+
+func TestCheckGlossary(t *testing.T) {
+	glossary := newTestGlossary(t)
+
+	assert.Empty(t, glossary.CheckGlossary("de", "", "Open workspace", "Arbeitsbereiche öffnen"))
+	assert.Empty(t, glossary.CheckGlossary("de", "", "Welcome to Acme", "Willkommen bei Acme"))
+	assert.Empty(t, glossary.CheckGlossary("de", "", "Open workspace", ""))
+
+	issues := glossary.CheckGlossary("de", "", "Open workspace", "Arbeitsplatz öffnen")
+	require.Len(t, issues, 1)
+	assert.Equal(t, "glossary", issues[0].Check)
+	assert.Equal(t, SeverityWarning, issues[0].Severity)
+
+	issues = glossary.CheckGlossary("de", "", "Welcome to Acme", "Willkommen bei Akme")
+	require.Len(t, issues, 1)
+	assert.Equal(t, "do_not_translate", issues[0].Check)
+}
+
+func TestGlossarySaveLoad(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "glossary_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	glossary, err := LoadGlossary(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, glossary.Terms)
+
+	require.NoError(t, newTestGlossary(t).Save(tempDir))
+
+	glossary, err = LoadGlossary(tempDir)
+	require.NoError(t, err)
+	require.Len(t, glossary.Terms, 4)
+	assert.Equal(t, "Acme", glossary.Terms[0].Term)
+	assert.Equal(t, "de", glossary.Terms[2].Language)
+	assert.Equal(t, "fr", glossary.Terms[3].Language)
+}
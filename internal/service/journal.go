@@ -0,0 +1,393 @@
+package service
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// Statuses of a key after a revert
+const (
+	RevertReverted        = "reverted"
+	RevertAlreadyReverted = "already_reverted"
+	// RevertConflict means the key was changed again after the reverted changes
+	RevertConflict = "conflict"
+	// RevertMissing means the key is no longer in the catalog
+	RevertMissing = "missing"
+)
+
+// ProcessSession identifies the changes made by this server process in the journal
+var ProcessSession = NewJournalID()
+
+// journalMu serializes appends to the journals of this process
+var journalMu sync.Mutex
+
+// JournalEntry is a change of one translation recorded in the journal
+type JournalEntry struct {
+	ID       string    `json:"id"`
+	Time     time.Time `json:"time"`
+	Session  string    `json:"session"`
+	Client   string    `json:"client,omitempty"`
+	Tool     string    `json:"tool"`
+	File     string    `json:"file"`
+	Context  string    `json:"context,omitempty"`
+	MsgID    string    `json:"msgid"`
+	OldValue []string  `json:"old_value"`
+	NewValue []string  `json:"new_value"`
+	OldFlags []string  `json:"old_flags,omitempty"`
+	NewFlags []string  `json:"new_flags,omitempty"`
+	// OldComments and NewComments are the translator comments of the entry
+	OldComments []string `json:"old_comments,omitempty"`
+	NewComments []string `json:"new_comments,omitempty"`
+	// Created is set when the change added the entry to the catalog
+	Created bool `json:"created,omitempty"`
+	// Removed is set when the change deleted the entry from the catalog
+	Removed bool `json:"removed,omitempty"`
+	// OldEntry and OldIndex are the PO lines and the position of an entry the change
+	// deleted, so a revert restores its plural forms, comments and references in place
+	OldEntry string `json:"old_entry,omitempty"`
+	OldIndex int    `json:"old_index,omitempty"`
+	// Reverts lists the changes undone by this change
+	Reverts []string `json:"reverts,omitempty"`
+}
+
+// JournalFilter selects journal entries. Empty fields match every entry.
+type JournalFilter struct {
+	// Path is a .po file or a directory containing the changed files
+	Path    string
+	Context string
+	MsgID   string
+	Session string
+	Since   time.Time
+}
+
+// JournalSession summarizes the changes of one session
+type JournalSession struct {
+	Session string    `json:"session"`
+	Client  string    `json:"client,omitempty"`
+	First   time.Time `json:"first"`
+	Last    time.Time `json:"last"`
+	Count   int       `json:"count"`
+}
+
+// Journal is the append-only log of the translation changes made in a project. It is
+// kept in the data directory, one JSON entry per line.
+type Journal struct {
+	Root string
+	Path string
+}
+
+// RevertTarget is the value a key is restored to by a revert
+type RevertTarget struct {
+	File    string `json:"file"`
+	Context string `json:"context,omitempty"`
+	MsgID   string `json:"msgid"`
+	// Value is the translation before the first reverted change
+	Value []string `json:"value"`
+	// Remove is set when the first reverted change added the entry
+	Remove bool `json:"remove,omitempty"`
+	// Expected is the translation after the last reverted change
+	Expected []string `json:"expected"`
+	// Flags and Comments are the flags and translator comments of the entry before
+	// the first reverted change
+	Flags    []string `json:"-"`
+	Comments []string `json:"-"`
+	// Entry and Index are the lines and position of the entry when the first reverted
+	// change deleted it
+	Entry string `json:"-"`
+	Index int    `json:"-"`
+	// Deleted is set when the last reverted change removed the entry
+	Deleted   bool     `json:"-"`
+	ChangeIDs []string `json:"change_ids"`
+	Status    string   `json:"status"`
+	// Error tells why the file could not be written or the revert not journaled
+	Error string `json:"error,omitempty"`
+}
+
+// NewJournalID returns a random identifier for a journal entry or session
+func NewJournalID() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// OpenJournal returns the journal of the project at root
+func OpenJournal(root string) (*Journal, error) {
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	path, err := utils.ProjectDataPath(root, "journal", ".jsonl")
+	if err != nil {
+		return nil, err
+	}
+	return &Journal{Root: root, Path: path}, nil
+}
+
+// Append adds entries to the journal, filling in their ID and time when missing
+func (j *Journal) Append(entries []JournalEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	var sb strings.Builder
+	for i := range entries {
+		if entries[i].ID == "" {
+			entries[i].ID = NewJournalID()
+		}
+		if entries[i].Time.IsZero() {
+			entries[i].Time = time.Now().UTC()
+		}
+		line, err := json.Marshal(entries[i])
+		if err != nil {
+			return err
+		}
+		sb.Write(line)
+		sb.WriteByte('\n')
+	}
+
+	journalMu.Lock()
+	defer journalMu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(j.Path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(j.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(sb.String()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Entries returns the entries of the journal matching filter, oldest first. Lines that
+// cannot be decoded, such as a line cut short by a crash, are skipped.
+func (j *Journal) Entries(filter JournalFilter) ([]JournalEntry, error) {
+	entries := []JournalEntry{}
+	f, err := os.Open(j.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var entry JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if filter.matches(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].Time.Before(entries[b].Time)
+	})
+	return entries, nil
+}
+
+func (f JournalFilter) matches(entry JournalEntry) bool {
+	if f.Path != "" {
+		path := absPath(f.Path)
+		if entry.File != path && !strings.HasPrefix(entry.File, path+string(filepath.Separator)) {
+			return false
+		}
+	}
+	if f.MsgID != "" && (entry.MsgID != f.MsgID || entry.Context != f.Context) {
+		return false
+	}
+	if f.Session != "" && entry.Session != f.Session {
+		return false
+	}
+	return f.Since.IsZero() || !entry.Time.Before(f.Since)
+}
+
+// JournalSessions summarizes the sessions of the given entries, most recent first
+func JournalSessions(entries []JournalEntry) []JournalSession {
+	bySession := make(map[string]*JournalSession)
+	for _, entry := range entries {
+		session, ok := bySession[entry.Session]
+		if !ok {
+			session = &JournalSession{Session: entry.Session, Client: entry.Client, First: entry.Time}
+			bySession[entry.Session] = session
+		}
+		if entry.Time.Before(session.First) {
+			session.First = entry.Time
+		}
+		if entry.Time.After(session.Last) {
+			session.Last = entry.Time
+		}
+		session.Count++
+	}
+
+	sessions := make([]JournalSession, 0, len(bySession))
+	for _, session := range bySession {
+		sessions = append(sessions, *session)
+	}
+	sort.Slice(sessions, func(a, b int) bool {
+		return sessions[a].Last.After(sessions[b].Last)
+	})
+	return sessions
+}
+
+// TranslationJournalEntries returns the journal entries for the translations applied
+// to the catalog of a file, with the flags the entries have now
+func TranslationJournalEntries(catalog *utils.Catalog, applied []AppliedTranslation, file, tool, session, client string) []JournalEntry {
+	entries := make([]JournalEntry, 0, len(applied))
+	now := time.Now().UTC()
+	for _, change := range applied {
+		var newFlags, newComments []string
+		if entry := catalog.Lookup(change.Context, change.MsgID); entry != nil {
+			newFlags, newComments = slices.Clone(entry.Flags), slices.Clone(entry.TranslatorComments)
+		}
+		entries = append(entries, JournalEntry{
+			ID:          NewJournalID(),
+			Time:        now,
+			Session:     session,
+			Client:      client,
+			Tool:        tool,
+			File:        absPath(file),
+			Context:     change.Context,
+			MsgID:       change.MsgID,
+			OldValue:    change.OldValue,
+			NewValue:    change.NewValue,
+			OldFlags:    change.OldFlags,
+			NewFlags:    newFlags,
+			OldComments: change.OldComments,
+			NewComments: newComments,
+			Created:     change.Created,
+		})
+	}
+	return entries
+}
+
+// PlanRevert groups changes by key. Each key is restored to its value before the
+// earliest of its changes. The targets are ordered by file and key.
+func PlanRevert(changes []JournalEntry) []*RevertTarget {
+	sorted := slices.Clone(changes)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].Time.Before(sorted[b].Time)
+	})
+
+	byKey := make(map[string]*RevertTarget)
+	targets := []*RevertTarget{}
+	for _, change := range sorted {
+		key := change.File + "\x00" + utils.EntryKey(change.Context, change.MsgID)
+		target, ok := byKey[key]
+		if !ok {
+			target = &RevertTarget{
+				File:     change.File,
+				Context:  change.Context,
+				MsgID:    change.MsgID,
+				Value:    change.OldValue,
+				Flags:    change.OldFlags,
+				Comments: change.OldComments,
+				Entry:    change.OldEntry,
+				Index:    change.OldIndex,
+				Remove:   change.Created,
+			}
+			byKey[key] = target
+			targets = append(targets, target)
+		}
+		target.Expected = change.NewValue
+		target.Deleted = change.Removed
+		target.ChangeIDs = append(target.ChangeIDs, change.ID)
+	}
+
+	sort.SliceStable(targets, func(a, b int) bool {
+		if targets[a].File != targets[b].File {
+			return targets[a].File < targets[b].File
+		}
+		return utils.EntryKey(targets[a].Context, targets[a].MsgID) < utils.EntryKey(targets[b].Context, targets[b].MsgID)
+	})
+	return targets
+}
+
+// ApplyRevert restores a key of a catalog and sets the status of the target. A key
+// that changed again after the reverted changes is left alone unless force is set.
+// It returns the journal entry of the revert, or nil when nothing changed.
+func ApplyRevert(catalog *utils.Catalog, target *RevertTarget, force bool) *JournalEntry {
+	entry := catalog.Lookup(target.Context, target.MsgID)
+
+	// Already back at the original value
+	if (target.Remove && entry == nil) || (!target.Remove && entry != nil && slices.Equal(entry.Str, target.Value)) {
+		target.Status = RevertAlreadyReverted
+		return nil
+	}
+	var current, currentFlags, currentComments []string
+	if entry != nil {
+		current, currentFlags, currentComments = entry.Str, slices.Clone(entry.Flags), slices.Clone(entry.TranslatorComments)
+	}
+	changedSince := target.Deleted != (entry == nil) || (entry != nil && !slices.Equal(current, target.Expected))
+	if changedSince && !force {
+		target.Status = RevertConflict
+		return nil
+	}
+
+	change := &JournalEntry{
+		File:        target.File,
+		Context:     target.Context,
+		MsgID:       target.MsgID,
+		OldValue:    slices.Clone(current),
+		NewValue:    target.Value,
+		OldFlags:    currentFlags,
+		NewFlags:    target.Flags,
+		OldComments: currentComments,
+		NewComments: target.Comments,
+		Reverts:     target.ChangeIDs,
+	}
+	switch {
+	case target.Remove:
+		change.OldEntry, change.OldIndex = entry.Block(), catalog.Index(target.Context, target.MsgID)
+		catalog.Remove(target.Context, target.MsgID)
+		change.Removed = true
+	case entry == nil && target.Entry != "":
+		restored, err := utils.ParseCatalogEntry(target.Entry)
+		if err != nil || restored.Context != target.Context || restored.ID != target.MsgID {
+			target.Status = RevertMissing
+			return nil
+		}
+		catalog.Insert(target.Index, restored)
+		change.Created = true
+	case entry == nil:
+		if len(target.Value) == 0 {
+			target.Status = RevertMissing
+			return nil
+		}
+		entry = catalog.Set(target.Context, target.MsgID, target.Value[0])
+		entry.Str = slices.Clone(target.Value)
+		entry.Flags = slices.Clone(target.Flags)
+		entry.TranslatorComments = slices.Clone(target.Comments)
+		change.Created = true
+	default:
+		entry.Str = slices.Clone(target.Value)
+		if len(entry.Str) == 0 {
+			entry.Str = []string{""}
+		}
+		entry.Flags = slices.Clone(target.Flags)
+		entry.TranslatorComments = slices.Clone(target.Comments)
+	}
+	target.Status = RevertReverted
+	return change
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	root := t.TempDir()
+	journal, err := OpenJournal(root)
+	require.NoError(t, err)
+
+	// A missing journal has no entries
+	entries, err := journal.Entries(JournalFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	de := filepath.Join(root, "locales", "de.po")
+	fr := filepath.Join(root, "locales", "fr.po")
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, journal.Append([]JournalEntry{
+		{Time: start, Session: "a", Client: "editor 1.0", Tool: "translate", File: de, MsgID: "Save", OldValue: []string{""}, NewValue: []string{"Speichern"}},
+		{Time: start.Add(time.Minute), Session: "a", Tool: "translate", File: fr, MsgID: "Save", OldValue: []string{""}, NewValue: []string{"Enregistrer"}},
+	}))
+	require.NoError(t, journal.Append([]JournalEntry{
+		{Time: start.Add(time.Hour), Session: "b", Tool: "translate", File: de, MsgID: "Save", OldValue: []string{"Speichern"}, NewValue: []string{"Sichern"}},
+	}))
+
+	// A line cut short is skipped
+	f, err := os.OpenFile(journal.Path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"id": "broken`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	entries, err = journal.Entries(JournalFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.NotEmpty(t, entries[0].ID)
+	assert.NotEqual(t, entries[0].ID, entries[1].ID)
+
+	entries, err = journal.Entries(JournalFilter{Path: de})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = journal.Entries(JournalFilter{Path: filepath.Join(root, "locales"), Session: "a"})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = journal.Entries(JournalFilter{Path: filepath.Join(root, "loc")})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	entries, err = journal.Entries(JournalFilter{Since: start.Add(time.Minute)})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = journal.Entries(JournalFilter{})
+	require.NoError(t, err)
+	sessions := JournalSessions(entries)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "b", sessions[0].Session)
+	assert.Equal(t, JournalSession{Session: "a", Client: "editor 1.0", First: start, Last: start.Add(time.Minute), Count: 2}, sessions[1])
+}
+
+func TestRevert(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	changes := []JournalEntry{
+		{ID: "1", Time: start, File: "de.po", MsgID: "Save", OldValue: []string{"Speichern"}, NewValue: []string{"Sichern"}},
+		{ID: "2", Time: start.Add(time.Second), File: "de.po", MsgID: "Save", OldValue: []string{"Sichern"}, NewValue: []string{"Absichern"}},
+		{ID: "3", Time: start, File: "de.po", MsgID: "New", NewValue: []string{"Neu"}, Created: true},
+		{ID: "4", Time: start, File: "de.po", MsgID: "Open", OldValue: []string{""}, NewValue: []string{"Öffnen"}},
+	}
+	targets := PlanRevert(changes)
+	require.Len(t, targets, 3)
+	assert.Equal(t, "New", targets[0].MsgID)
+	assert.Equal(t, "Open", targets[1].MsgID)
+	save := targets[2]
+	assert.Equal(t, []string{"Speichern"}, save.Value)
+	assert.Equal(t, []string{"Absichern"}, save.Expected)
+	assert.Equal(t, []string{"1", "2"}, save.ChangeIDs)
+
+	catalog, err := utils.ParseCatalog([]byte("msgid \"Save\"\nmsgstr \"Absichern\"\n\nmsgid \"Open\"\nmsgstr \"Aufmachen\"\n\nmsgid \"New\"\nmsgstr \"Neu\"\n"))
+	require.NoError(t, err)
+
+	change := ApplyRevert(catalog, save, false)
+	require.NotNil(t, change)
+	assert.Equal(t, RevertReverted, save.Status)
+	assert.Equal(t, []string{"Absichern"}, change.OldValue)
+	assert.Equal(t, []string{"1", "2"}, change.Reverts)
+	assert.Equal(t, []string{"Speichern"}, catalog.Lookup("", "Save").Str)
+
+	// Reverting again changes nothing
+	assert.Nil(t, ApplyRevert(catalog, save, false))
+	assert.Equal(t, RevertAlreadyReverted, save.Status)
+
+	// Open was changed after the journaled change
+	open := targets[1]
+	assert.Nil(t, ApplyRevert(catalog, open, false))
+	assert.Equal(t, RevertConflict, open.Status)
+	assert.Equal(t, []string{"Aufmachen"}, catalog.Lookup("", "Open").Str)
+	require.NotNil(t, ApplyRevert(catalog, open, true))
+	assert.Equal(t, []string{""}, catalog.Lookup("", "Open").Str)
+
+	// An added entry is removed
+	added := targets[0]
+	change = ApplyRevert(catalog, added, false)
+	require.NotNil(t, change)
+	assert.True(t, change.Removed)
+	assert.Nil(t, catalog.Lookup("", "New"))
+
+	// Reverting the removal adds the entry back
+	restore := PlanRevert([]JournalEntry{*change})[0]
+	require.NotNil(t, ApplyRevert(catalog, restore, false))
+	assert.Equal(t, []string{"Neu"}, catalog.Lookup("", "New").Str)
+}
+
+func TestTranslationJournalEntries(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte("msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Öffnen\"\n\nmsgid \"Open\"\nmsgstr \"Offen\"\n"))
+	require.NoError(t, err)
+	menu := catalog.Lookup("menu", "Open")
+	menu.AddFlag("review:draft")
+
+	applied := []AppliedTranslation{{Context: "menu", MsgID: "Open", OldValue: []string{""}, NewValue: []string{"Öffnen"}}}
+	entries := TranslationJournalEntries(catalog, applied, "de.po", "translate", "s", "")
+	require.Len(t, entries, 1)
+	assert.Equal(t, "menu", entries[0].Context)
+	assert.Equal(t, []string{"review:draft"}, entries[0].NewFlags)
+
+	// The revert restores the entry of the context only
+	require.NotNil(t, ApplyRevert(catalog, PlanRevert(entries)[0], false))
+	assert.Equal(t, []string{""}, catalog.Lookup("menu", "Open").Str)
+	assert.Equal(t, []string{"Offen"}, catalog.Lookup("", "Open").Str)
+}
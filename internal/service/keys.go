@@ -0,0 +1,115 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// Outcomes of renaming or deleting a key in one catalog
+const (
+	// KeyRenamed is a key moved to the new msgid with its translation
+	KeyRenamed = "renamed"
+	// KeyMerged is a key whose translation was carried over to the untranslated entry
+	// already present under the new msgid
+	KeyMerged = "merged"
+	// KeyConflict is a key left alone because the new msgid is already translated
+	KeyConflict = "conflict"
+	// KeyDeleted is a key removed from the catalog
+	KeyDeleted = "deleted"
+	// KeyMissing is a key the catalog does not contain
+	KeyMissing = "missing"
+)
+
+// KeyChange is the outcome of renaming or deleting a key in the catalog of one file
+type KeyChange struct {
+	File     string   `json:"file"`
+	Language string   `json:"language,omitempty"`
+	Status   string   `json:"status"`
+	MsgStr   []string `json:"msgstr,omitempty"`
+	Fuzzy    bool     `json:"fuzzy,omitempty"`
+	// Error tells why the file could not be written or the change not journaled
+	Error string `json:"error,omitempty"`
+	// Journal records the change so revertChanges can undo it
+	Journal []JournalEntry `json:"-"`
+}
+
+// Changed reports whether the catalog was modified
+func (c KeyChange) Changed() bool {
+	return c.Status == KeyRenamed || c.Status == KeyMerged || c.Status == KeyDeleted
+}
+
+// RenameKey moves the translation of a key to a new context and msgid. A translated
+// entry is marked fuzzy when markFuzzy is set, with the old msgid kept as the previous
+// msgid so translators see what changed. When the new key already exists, an
+// untranslated entry takes over the translation and a translated one is a conflict.
+func RenameKey(catalog *utils.Catalog, file, oldContext, oldMsgID, newContext, newMsgID string, markFuzzy bool) KeyChange {
+	change := KeyChange{File: file, Status: KeyMissing}
+	entry := catalog.Lookup(oldContext, oldMsgID)
+	if entry == nil {
+		return change
+	}
+	change.MsgStr = slices.Clone(entry.Str)
+	removed := removalJournalEntry(catalog, file, entry)
+
+	created := JournalEntry{File: absPath(file), Context: newContext, MsgID: newMsgID}
+	target := catalog.Lookup(newContext, newMsgID)
+	switch {
+	case target == nil:
+		entry.Context, entry.ID = newContext, newMsgID
+		target = entry
+		created.Created = true
+		change.Status = KeyRenamed
+	case target.IsTranslated() || len(target.Str) != len(entry.Str):
+		change.Status = KeyConflict
+		return change
+	default:
+		created.OldValue = slices.Clone(target.Str)
+		created.OldFlags = slices.Clone(target.Flags)
+		created.OldComments = slices.Clone(target.TranslatorComments)
+		target.Str = slices.Clone(entry.Str)
+		catalog.Remove(oldContext, oldMsgID)
+		change.Status = KeyMerged
+	}
+
+	if markFuzzy && slices.ContainsFunc(target.Str, func(s string) bool { return s != "" }) {
+		target.AddFlag("fuzzy")
+		target.PreviousContext, target.PreviousID, target.PreviousPluralID = oldContext, oldMsgID, entry.PluralID
+		change.Fuzzy = true
+	}
+	created.NewValue = slices.Clone(target.Str)
+	created.NewFlags = slices.Clone(target.Flags)
+	created.NewComments = slices.Clone(target.TranslatorComments)
+	change.Journal = []JournalEntry{removed, created}
+	return change
+}
+
+// DeleteKey removes a key from a catalog
+func DeleteKey(catalog *utils.Catalog, file, context, msgid string) KeyChange {
+	change := KeyChange{File: file, Status: KeyMissing}
+	entry := catalog.Lookup(context, msgid)
+	if entry == nil {
+		return change
+	}
+	change.MsgStr = slices.Clone(entry.Str)
+	change.Journal = []JournalEntry{removalJournalEntry(catalog, file, entry)}
+	catalog.Remove(context, msgid)
+	change.Status = KeyDeleted
+	return change
+}
+
+// removalJournalEntry records the removal of an entry from the catalog of file, with
+// its lines and position so a revert puts it back as it was
+func removalJournalEntry(catalog *utils.Catalog, file string, entry *utils.CatalogEntry) JournalEntry {
+	return JournalEntry{
+		File:        absPath(file),
+		Context:     entry.Context,
+		MsgID:       entry.ID,
+		OldValue:    slices.Clone(entry.Str),
+		OldFlags:    slices.Clone(entry.Flags),
+		OldComments: slices.Clone(entry.TranslatorComments),
+		OldEntry:    entry.Block(),
+		OldIndex:    catalog.Index(entry.Context, entry.ID),
+		Removed:     true,
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameKey(t *testing.T) {
+	parse := func(t *testing.T, content string) *utils.Catalog {
+		catalog, err := utils.ParseCatalog([]byte(content))
+		require.NoError(t, err)
+		return catalog
+	}
+
+	t.Run("Rename Translated", func(t *testing.T) {
+		catalog := parse(t, "#: src/app.js:3\nmsgid \"Save\"\nmsgstr \"Speichern\"\n")
+		change := RenameKey(catalog, "de.po", "", "Save", "", "Save changes", true)
+		assert.Equal(t, KeyRenamed, change.Status)
+		assert.True(t, change.Fuzzy)
+		assert.Nil(t, catalog.Lookup("", "Save"))
+
+		entry := catalog.Lookup("", "Save changes")
+		require.NotNil(t, entry)
+		assert.Equal(t, []string{"Speichern"}, entry.Str)
+		assert.True(t, entry.IsFuzzy())
+		assert.Equal(t, []string{"src/app.js:3"}, entry.References)
+		assert.Contains(t, string(catalog.Marshal()), "#| msgid \"Save\"\nmsgid \"Save changes\"\n")
+
+		// The journal records the old key as removed and the new one as created, so
+		// reverting restores the old key
+		require.Len(t, change.Journal, 2)
+		assert.True(t, change.Journal[0].Removed)
+		assert.True(t, change.Journal[1].Created)
+		for _, target := range PlanRevert(change.Journal) {
+			require.NotNil(t, ApplyRevert(catalog, target, false))
+		}
+		assert.Nil(t, catalog.Lookup("", "Save changes"))
+		assert.Equal(t, []string{"Speichern"}, catalog.Lookup("", "Save").Str)
+		assert.False(t, catalog.Lookup("", "Save").IsFuzzy())
+	})
+
+	t.Run("Rename Untranslated Or Without Fuzzy", func(t *testing.T) {
+		catalog := parse(t, "msgid \"Save\"\nmsgstr \"\"\n\nmsgid \"Open\"\nmsgstr \"Öffnen\"\n")
+		assert.False(t, RenameKey(catalog, "de.po", "", "Save", "", "Store", true).Fuzzy)
+		assert.False(t, RenameKey(catalog, "de.po", "", "Open", "menu", "Open", false).Fuzzy)
+		assert.False(t, catalog.Lookup("menu", "Open").IsFuzzy())
+	})
+
+	t.Run("Merge Into Untranslated Key", func(t *testing.T) {
+		catalog := parse(t, "msgid \"Save\"\nmsgstr \"Speichern\"\n\nmsgid \"Save changes\"\nmsgstr \"\"\n")
+		change := RenameKey(catalog, "de.po", "", "Save", "", "Save changes", true)
+		assert.Equal(t, KeyMerged, change.Status)
+		require.Len(t, catalog.Entries, 1)
+		assert.Equal(t, []string{"Speichern"}, catalog.Lookup("", "Save changes").Str)
+		assert.Equal(t, []string{""}, change.Journal[1].OldValue)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		catalog := parse(t, "msgid \"Save\"\nmsgstr \"Speichern\"\n\nmsgid \"Save changes\"\nmsgstr \"Änderungen speichern\"\n")
+		change := RenameKey(catalog, "de.po", "", "Save", "", "Save changes", true)
+		assert.Equal(t, KeyConflict, change.Status)
+		assert.False(t, change.Changed())
+		assert.Empty(t, change.Journal)
+		assert.Equal(t, []string{"Speichern"}, catalog.Lookup("", "Save").Str)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		catalog := parse(t, "msgid \"Open\"\nmsgstr \"Öffnen\"\n")
+		assert.Equal(t, KeyMissing, RenameKey(catalog, "de.po", "", "Save", "", "Store", true).Status)
+	})
+}
+
+func TestDeleteKey(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte("msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Öffnen\"\n\nmsgid \"Open\"\nmsgstr \"Offen\"\n"))
+	require.NoError(t, err)
+
+	change := DeleteKey(catalog, "de.po", "menu", "Open")
+	assert.Equal(t, KeyDeleted, change.Status)
+	assert.Equal(t, []string{"Öffnen"}, change.MsgStr)
+	assert.Nil(t, catalog.Lookup("menu", "Open"))
+	assert.NotNil(t, catalog.Lookup("", "Open"))
+
+	assert.Equal(t, KeyMissing, DeleteKey(catalog, "de.po", "menu", "Open").Status)
+
+	// Reverting adds the entry back
+	require.NotNil(t, ApplyRevert(catalog, PlanRevert(change.Journal)[0], false))
+	assert.Equal(t, []string{"Öffnen"}, catalog.Lookup("menu", "Open").Str)
+
+	t.Run("Revert Plural Entry In Place", func(t *testing.T) {
+		content := `msgid "First"
+msgstr "Erste"
+
+#. Shown in the toolbar
+#: src/files.js:12
+#, c-format
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Datei"
+msgstr[1] "%d Dateien"
+
+msgid "Last"
+msgstr "Letzte"
+
+#~ msgid "Old"
+#~ msgstr "Alt"
+`
+		catalog, err := utils.ParseCatalog([]byte(content))
+		require.NoError(t, err)
+
+		change := DeleteKey(catalog, "de.po", "", "%d file")
+		require.Equal(t, KeyDeleted, change.Status)
+		assert.Equal(t, 1, change.Journal[0].OldIndex)
+
+		require.NotNil(t, ApplyRevert(catalog, PlanRevert(change.Journal)[0], false))
+		assert.Equal(t, content, string(catalog.Marshal()))
+	})
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// LanguageTranslation is the translation of a message in the catalog of one language
+type LanguageTranslation struct {
+	Language   string   `json:"language"`
+	File       string   `json:"file"`
+	MsgStr     []string `json:"msgstr"`
+	Flags      []string `json:"flags,omitempty"`
+	Translated bool     `json:"translated"`
+	Fuzzy      bool     `json:"fuzzy,omitempty"`
+	// Provenance tells where the translation came from, when it was recorded
+	Provenance *Provenance `json:"provenance,omitempty"`
+}
+
+// LoadCatalogFiles parses the .po files found under directory. Files without a known
+// language or that cannot be parsed are returned as skipped. The catalogs can be
+// modified and written back.
+func LoadCatalogFiles(directory string) ([]CatalogFile, []string, error) {
+	return catalogFiles(directory, utils.LoadCatalog)
+}
+
+// ReadCatalogFiles is LoadCatalogFiles for reading only. The catalogs are shared through
+// the catalog cache and must not be modified.
+func ReadCatalogFiles(directory string) ([]CatalogFile, []string, error) {
+	return catalogFiles(directory, utils.ReadCatalog)
+}
+
+func catalogFiles(directory string, load func(path string) (*utils.Catalog, error)) ([]CatalogFile, []string, error) {
+	poFilesInfo, err := utils.ScanPoFilesWithInfo(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := []CatalogFile{}
+	skipped := []string{}
+	for _, info := range poFilesInfo {
+		if info.Language == "" {
+			skipped = append(skipped, info.Path)
+			continue
+		}
+		catalog, err := load(info.Path)
+		if err != nil {
+			skipped = append(skipped, info.Path)
+			continue
+		}
+		files = append(files, CatalogFile{Path: info.Path, Language: info.Language, Catalog: catalog})
+	}
+	return files, skipped, nil
+}
+
+// MessageTranslations returns the translation of a message in every file containing it,
+// ordered by language and path
+func MessageTranslations(files []CatalogFile, context, msgid string) []LanguageTranslation {
+	translations := []LanguageTranslation{}
+	for _, file := range files {
+		entry := file.Catalog.Lookup(context, msgid)
+		if entry == nil || entry.Obsolete {
+			continue
+		}
+		translations = append(translations, LanguageTranslation{
+			Language:   file.Language,
+			File:       file.Path,
+			MsgStr:     entry.Str,
+			Flags:      entry.Flags,
+			Translated: entry.IsTranslated(),
+			Fuzzy:      entry.IsFuzzy(),
+			Provenance: GetProvenance(entry),
+		})
+	}
+	sort.SliceStable(translations, func(i, j int) bool {
+		if translations[i].Language != translations[j].Language {
+			return translations[i].Language < translations[j].Language
+		}
+		return translations[i].File < translations[j].File
+	})
+	return translations
+}
+
+// SiblingCatalogs returns the files of the same domain as path (see utils.CatalogDomain)
+// whose language matches one of the given languages
+func SiblingCatalogs(files []CatalogFile, path string, languages []string) []CatalogFile {
+	path = absPath(path)
+	domain := utils.CatalogDomain(path)
+	siblings := []CatalogFile{}
+	for _, file := range files {
+		filePath := absPath(file.Path)
+		if filePath == path || utils.CatalogDomain(filePath) != domain {
+			continue
+		}
+		for _, language := range languages {
+			if utils.LanguagesMatch(language, file.Language) {
+				siblings = append(siblings, file)
+				break
+			}
+		}
+	}
+	return siblings
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCatalogFiles(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_languages_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"es.po":               "msgid \"Save\"\nmsgstr \"Guardar\"\n",
+		"pt_BR.po":            "msgid \"\"\nmsgstr \"\"\n\"Language: pt_BR\\n\"\n\n#, fuzzy\nmsgid \"Save\"\nmsgstr \"Salvar\"\n",
+		"unknown.po":          "msgid \"Save\"\nmsgstr \"\"\n",
+		"locales/de/main.po":  "msgid \"Cancel\"\nmsgstr \"Abbrechen\"\n",
+		"locales/fr/other.po": "msgctxt \"menu\"\nmsgid \"Save\"\nmsgstr \"Enregistrer\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	catalogFiles, skipped, err := LoadCatalogFiles(tempDir)
+	require.NoError(t, err)
+	assert.Len(t, catalogFiles, 4)
+	assert.Equal(t, []string{filepath.Join(tempDir, "unknown.po")}, skipped)
+
+	translations := MessageTranslations(catalogFiles, "", "Save")
+	require.Len(t, translations, 2)
+	assert.Equal(t, "es", translations[0].Language)
+	assert.Equal(t, []string{"Guardar"}, translations[0].MsgStr)
+	assert.True(t, translations[0].Translated)
+	assert.Equal(t, "pt-BR", translations[1].Language)
+	assert.True(t, translations[1].Fuzzy)
+	assert.Equal(t, []string{"fuzzy"}, translations[1].Flags)
+
+	translations = MessageTranslations(catalogFiles, "menu", "Save")
+	require.Len(t, translations, 1)
+	assert.Equal(t, "fr", translations[0].Language)
+}
+
+func TestSiblingCatalogs(t *testing.T) {
+	catalogFile := func(path, language string) CatalogFile {
+		file := parseTestCatalogFile(t, filepath.FromSlash(path), "msgid \"Save\"\nmsgstr \"x\"\n")
+		file.Language = language
+		return file
+	}
+	files := []CatalogFile{
+		catalogFile("locale/es/LC_MESSAGES/app.po", "es"),
+		catalogFile("locale/es/LC_MESSAGES/admin.po", "es"),
+		catalogFile("locale/it/LC_MESSAGES/app.po", "it"),
+		catalogFile("locale/fr/LC_MESSAGES/app.po", "fr"),
+		catalogFile("locale/pt_BR/LC_MESSAGES/app.po", "pt-BR"),
+	}
+
+	siblings := SiblingCatalogs(files, filepath.FromSlash("locale/pt_BR/LC_MESSAGES/app.po"), []string{"es", "it", "pt"})
+	var paths []string
+	for _, sibling := range siblings {
+		paths = append(paths, filepath.ToSlash(sibling.Path))
+	}
+	assert.Equal(t, []string{"locale/es/LC_MESSAGES/app.po", "locale/it/LC_MESSAGES/app.po"}, paths)
+}
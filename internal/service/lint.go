@@ -0,0 +1,259 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// LintCheck describes one of the checks run by LintEntry
+type LintCheck struct {
+	ID          string `json:"id"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+
+	// run returns a message for each problem found; nil for the markup checks
+	// which are run through ValidateTranslation
+	run func(source, target string) []string
+}
+
+var (
+	numberPattern = regexp.MustCompile(`\d+(?:[.,\x{00A0}\x{202F}]\d+)*`)
+	urlPattern    = regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s<>"')\]]+|\bwww\.[^\s<>"')\]]+`)
+	emailPattern  = regexp.MustCompile(`[\w.+-]+@[\w-]+(?:\.[\w-]+)+`)
+
+	// markupOrPlaceholder matches printf directives, {name} placeholders and tags,
+	// which are skipped when looking for the first letter of a text
+	markupOrPlaceholder = regexp.MustCompile(`%(?:\d+\$)?[-+ #0]*\d*(?:\.\d+)?[a-zA-Z]|%\([^)]*\)[a-zA-Z]|\{[^{}]*\}|<[^<>]*>`)
+)
+
+// endPunctuation maps full-width and typographic end punctuation to its ASCII equivalent
+var endPunctuation = map[string]string{
+	".": ".", "!": "!", "?": "?", ":": ":", ";": ";",
+	"。": ".", "．": ".", "！": "!", "？": "?", "：": ":", "；": ";",
+	"…": "...", "...": "...", "।": ".", "؟": "?",
+}
+
+// LintChecks are all checks run by lintTranslations, in report order
+var LintChecks = []LintCheck{
+	{ID: "leading_whitespace", Severity: SeverityWarning, Description: "Leading whitespace differs from the source", run: checkLeadingWhitespace},
+	{ID: "trailing_whitespace", Severity: SeverityWarning, Description: "Trailing whitespace differs from the source", run: checkTrailingWhitespace},
+	{ID: "newlines", Severity: SeverityWarning, Description: "Number of newlines or leading/trailing newlines differ from the source", run: checkNewlines},
+	{ID: "end_punctuation", Severity: SeverityWarning, Description: "End punctuation differs from the source", run: checkEndPunctuation},
+	{ID: "untranslated", Severity: SeverityWarning, Description: "Translation is an unchanged copy of the source", run: checkUntranslated},
+	{ID: "double_space", Severity: SeverityWarning, Description: "Translation contains doubled spaces the source does not have", run: checkDoubleSpace},
+	{ID: "capitalization", Severity: SeverityWarning, Description: "Capitalization of the first letter differs from the source", run: checkCapitalization},
+	{ID: "numbers", Severity: SeverityWarning, Description: "Numbers of the source are missing from the translation", run: checkNumbers},
+	{ID: "urls", Severity: SeverityError, Description: "URLs of the source are missing or altered in the translation", run: checkURLs},
+	{ID: "emails", Severity: SeverityError, Description: "Email addresses of the source are missing or altered in the translation", run: checkEmails},
+	{ID: "html_tags", Severity: SeverityError, Description: "HTML tags differ from the source"},
+	{ID: "tag_nesting", Severity: SeverityError, Description: "HTML tags are not properly nested"},
+	{ID: "tag_attributes", Severity: SeverityError, Description: "Non-translatable tag attributes differ from the source"},
+	{ID: "html_entities", Severity: SeverityWarning, Description: "HTML entities differ from the source"},
+	{ID: "markdown_links", Severity: SeverityError, Description: "Markdown link targets differ from the source"},
+}
+
+// SelectLintChecks returns the IDs of the checks to run. An empty enable list selects
+// every check; disabled checks are removed afterwards. Unknown IDs are an error.
+func SelectLintChecks(enable, disable []string) (map[string]bool, error) {
+	known := make(map[string]bool, len(LintChecks))
+	for _, check := range LintChecks {
+		known[check.ID] = true
+	}
+	for _, id := range append(append([]string{}, enable...), disable...) {
+		if !known[id] {
+			return nil, fmt.Errorf("unknown check %q", id)
+		}
+	}
+
+	selected := make(map[string]bool)
+	if len(enable) == 0 {
+		for id := range known {
+			selected[id] = true
+		}
+	}
+	for _, id := range enable {
+		selected[id] = true
+	}
+	for _, id := range disable {
+		delete(selected, id)
+	}
+	return selected, nil
+}
+
+// LintEntry runs the selected checks on every translated msgstr form of an entry
+func LintEntry(entry *utils.CatalogEntry, selected map[string]bool) []ValidationIssue {
+	issues := []ValidationIssue{}
+	for i, target := range entry.Str {
+		if target == "" {
+			continue
+		}
+		source := entry.ID
+		if i > 0 && entry.PluralID != "" {
+			source = entry.PluralID
+		}
+
+		for _, check := range LintChecks {
+			if check.run == nil || !selected[check.ID] {
+				continue
+			}
+			for _, message := range check.run(source, target) {
+				issues = append(issues, ValidationIssue{
+					Context:  entry.Context,
+					MsgID:    entry.ID,
+					Check:    check.ID,
+					Severity: check.Severity,
+					Message:  message,
+				})
+			}
+		}
+		for _, issue := range ValidateTranslation(entry.Context, source, target) {
+			if selected[issue.Check] {
+				issue.MsgID = entry.ID
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
+
+func checkLeadingWhitespace(source, target string) []string {
+	s := source[:len(source)-len(strings.TrimLeft(source, " \t"))]
+	t := target[:len(target)-len(strings.TrimLeft(target, " \t"))]
+	if s != t {
+		return []string{fmt.Sprintf("leading whitespace %q differs from %q in the source", t, s)}
+	}
+	return nil
+}
+
+func checkTrailingWhitespace(source, target string) []string {
+	s := source[len(strings.TrimRight(source, " \t")):]
+	t := target[len(strings.TrimRight(target, " \t")):]
+	if s != t {
+		return []string{fmt.Sprintf("trailing whitespace %q differs from %q in the source", t, s)}
+	}
+	return nil
+}
+
+func checkNewlines(source, target string) []string {
+	var problems []string
+	if strings.HasPrefix(source, "\n") != strings.HasPrefix(target, "\n") {
+		problems = append(problems, "leading newline differs from the source")
+	}
+	if strings.HasSuffix(source, "\n") != strings.HasSuffix(target, "\n") {
+		problems = append(problems, "trailing newline differs from the source")
+	}
+	if s, t := strings.Count(source, "\n"), strings.Count(target, "\n"); len(problems) == 0 && s != t {
+		problems = append(problems, fmt.Sprintf("translation has %d newlines, the source has %d", t, s))
+	}
+	return problems
+}
+
+func checkEndPunctuation(source, target string) []string {
+	s, t := trailingPunctuation(source), trailingPunctuation(target)
+	if endPunctuation[s] != endPunctuation[t] {
+		return []string{fmt.Sprintf("ends with %q, the source ends with %q", t, s)}
+	}
+	return nil
+}
+
+// trailingPunctuation returns the end punctuation of s, ignoring trailing whitespace
+func trailingPunctuation(s string) string {
+	s = strings.TrimRightFunc(s, unicode.IsSpace)
+	if strings.HasSuffix(s, "...") {
+		return "..."
+	}
+	r, _ := utf8.DecodeLastRuneInString(s)
+	if _, ok := endPunctuation[string(r)]; ok {
+		return string(r)
+	}
+	return ""
+}
+
+func checkUntranslated(source, target string) []string {
+	if source != target || !strings.ContainsFunc(source, unicode.IsLetter) {
+		return nil
+	}
+	// Single words such as product names are often the same in every language
+	if !strings.ContainsFunc(strings.TrimSpace(source), unicode.IsSpace) {
+		return nil
+	}
+	return []string{"translation is identical to the source"}
+}
+
+func checkDoubleSpace(source, target string) []string {
+	if strings.Contains(strings.TrimSpace(target), "  ") && !strings.Contains(strings.TrimSpace(source), "  ") {
+		return []string{"translation contains a doubled space"}
+	}
+	return nil
+}
+
+func checkCapitalization(source, target string) []string {
+	s, t := firstLetter(source), firstLetter(target)
+	if !isCased(s) || !isCased(t) {
+		return nil
+	}
+	if unicode.IsUpper(s) != unicode.IsUpper(t) {
+		if unicode.IsUpper(s) {
+			return []string{"translation starts with a lowercase letter, the source with an uppercase letter"}
+		}
+		return []string{"translation starts with an uppercase letter, the source with a lowercase letter"}
+	}
+	return nil
+}
+
+func firstLetter(s string) rune {
+	for _, r := range markupOrPlaceholder.ReplaceAllString(s, " ") {
+		if unicode.IsLetter(r) {
+			return r
+		}
+	}
+	return 0
+}
+
+func isCased(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsLower(r)
+}
+
+// checkNumbers compares the digits of each number so "1,000" and "1.000" are the same number
+func checkNumbers(source, target string) []string {
+	digits := func(s string) []string {
+		var numbers []string
+		for _, n := range numberPattern.FindAllString(s, -1) {
+			numbers = append(numbers, strings.Map(func(r rune) rune {
+				if unicode.IsDigit(r) {
+					return r
+				}
+				return -1
+			}, n))
+		}
+		return numbers
+	}
+	missing, _ := diffMultiset(digits(source), digits(target))
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return []string{fmt.Sprintf("numbers missing from the translation: %s", strings.Join(missing, ", "))}
+	}
+	return nil
+}
+
+func checkURLs(source, target string) []string {
+	missing, _ := diffMultiset(urlPattern.FindAllString(source, -1), urlPattern.FindAllString(target, -1))
+	if len(missing) > 0 {
+		return []string{fmt.Sprintf("URLs missing or altered in the translation: %s", strings.Join(missing, ", "))}
+	}
+	return nil
+}
+
+func checkEmails(source, target string) []string {
+	missing, _ := diffMultiset(emailPattern.FindAllString(source, -1), emailPattern.FindAllString(target, -1))
+	if len(missing) > 0 {
+		return []string{fmt.Sprintf("email addresses missing or altered in the translation: %s", strings.Join(missing, ", "))}
+	}
+	return nil
+}
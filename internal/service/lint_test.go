@@ -0,0 +1,71 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lintChecks(t *testing.T, msgid, msgstr string) []string {
+	selected, err := SelectLintChecks(nil, nil)
+	require.NoError(t, err)
+
+	checks := []string{}
+	for _, issue := range LintEntry(&utils.CatalogEntry{ID: msgid, Str: []string{msgstr}}, selected) {
+		checks = append(checks, issue.Check)
+	}
+	return checks
+}
+
+func TestLintEntry(t *testing.T) {
+	tests := []struct {
+		name   string
+		msgid  string
+		msgstr string
+		checks []string
+	}{
+		{"Clean", "Save your changes.", "Enregistrez vos modifications.", []string{}},
+		{"Leading Whitespace", " Name", "Nom", []string{"leading_whitespace"}},
+		{"Trailing Whitespace", "Name: ", "Nom :", []string{"trailing_whitespace"}},
+		{"Trailing Newline", "Line one\n", "Ligne un", []string{"newlines"}},
+		{"Newline Count", "One\nTwo", "Un deux", []string{"newlines"}},
+		{"End Punctuation", "Are you sure?", "Êtes-vous sûr.", []string{"end_punctuation"}},
+		{"Full-width Punctuation", "Are you sure?", "本当によろしいですか？", []string{}},
+		{"Ellipsis", "Loading...", "Chargement…", []string{}},
+		{"Untranslated Copy", "Save your changes", "Save your changes", []string{"untranslated"}},
+		{"Single Word Copy", "Dashboard", "Dashboard", []string{}},
+		{"Double Space", "Save changes", "Enregistrer  les modifications", []string{"double_space"}},
+		{"Capitalization", "Save changes", "enregistrer les modifications", []string{"capitalization"}},
+		{"Placeholder Before Text", "%d Files", "%d Fichiers", []string{}},
+		{"Uncased Script", "Save changes", "変更を保存", []string{}},
+		{"Missing Number", "Up to 10 users", "Jusqu'à dix utilisateurs", []string{"numbers"}},
+		{"Localized Number", "Up to 1,000 users", "Bis zu 1.000 Benutzer", []string{}},
+		{"Altered URL", "See https://example.com/help", "Voir https://example.fr/aide", []string{"urls"}},
+		{"Altered Email", "Write to support@example.com", "Écrivez à support@exemple.fr", []string{"emails"}},
+		{"Markup", "Click <b>here</b>", "Cliquez <b>ici", []string{"html_tags"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.checks, lintChecks(t, tt.msgid, tt.msgstr))
+		})
+	}
+}
+
+func TestSelectLintChecks(t *testing.T) {
+	selected, err := SelectLintChecks(nil, []string{"capitalization"})
+	require.NoError(t, err)
+	assert.Len(t, selected, len(LintChecks)-1)
+	assert.False(t, selected["capitalization"])
+
+	selected, err = SelectLintChecks([]string{"urls", "emails"}, []string{"emails"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"urls": true}, selected)
+
+	entry := &utils.CatalogEntry{ID: "Save changes", Str: []string{"enregistrer  les modifications"}}
+	assert.Empty(t, LintEntry(entry, selected))
+
+	_, err = SelectLintChecks([]string{"spelling"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// DefaultMinScore is the lowest similarity returned by default as a suggestion
+const DefaultMinScore = 0.5
+
+// MemoryEntry is a translated msgid of a catalog in the translation memory
+type MemoryEntry struct {
+	Context string   `json:"context,omitempty"`
+	MsgID   string   `json:"msgid"`
+	MsgStr  []string `json:"msgstr"`
+	File    string   `json:"file"`
+}
+
+// Suggestion is a translation memory entry similar to a msgid
+type Suggestion struct {
+	Context     string   `json:"context,omitempty"`
+	MsgID       string   `json:"msgid"`
+	Translation string   `json:"translation"`
+	PluralForms []string `json:"plural_forms,omitempty"`
+	Score       float64  `json:"score"`
+	File        string   `json:"file"`
+}
+
+// TranslationMemory holds the translated entries of every catalog of one language
+type TranslationMemory struct {
+	Language string        `json:"language"`
+	Files    []string      `json:"files"`
+	Entries  []MemoryEntry `json:"entries"`
+
+	// seen keeps the msgid and msgstr pairs already in the memory
+	seen map[string]bool
+}
+
+// NewTranslationMemory returns an empty translation memory for a language
+func NewTranslationMemory(language string) *TranslationMemory {
+	return &TranslationMemory{
+		Language: language,
+		Files:    []string{},
+		Entries:  []MemoryEntry{},
+		seen:     make(map[string]bool),
+	}
+}
+
+// BuildTranslationMemory builds the translation memory of a language from all .po files
+// found under root. Files of a language matching the requested one (fr-FR for fr) are
+// included; files that cannot be parsed are skipped.
+func BuildTranslationMemory(root, language string) (*TranslationMemory, error) {
+	memory := NewTranslationMemory(language)
+	poFilesInfo, err := utils.ScanPoFilesWithInfo(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range poFilesInfo {
+		if info.Language == "" || !utils.LanguagesMatch(info.Language, language) {
+			continue
+		}
+		catalog, err := utils.ReadCatalog(info.Path)
+		if err != nil {
+			continue
+		}
+		memory.AddCatalog(info.Path, catalog)
+	}
+	return memory, nil
+}
+
+// AddCatalog adds the translated, non-fuzzy entries of a catalog. A msgid translated the
+// same way in several files is kept once, with the first file it was found in.
+func (m *TranslationMemory) AddCatalog(path string, catalog *utils.Catalog) {
+	if m.seen == nil {
+		m.seen = make(map[string]bool)
+	}
+	m.Files = append(m.Files, path)
+	for _, entry := range catalog.ActiveEntries() {
+		if !entry.IsTranslated() || entry.IsFuzzy() {
+			continue
+		}
+		key := entry.Key() + "\x00" + strings.Join(entry.Str, "\x00")
+		if m.seen[key] {
+			continue
+		}
+		m.seen[key] = true
+		m.Entries = append(m.Entries, MemoryEntry{
+			Context: entry.Context,
+			MsgID:   entry.ID,
+			MsgStr:  entry.Str,
+			File:    path,
+		})
+	}
+}
+
+// Suggest returns up to limit entries whose msgid is at least minScore similar to msgid,
+// best match first
+func (m *TranslationMemory) Suggest(msgid string, limit int, minScore float64) []Suggestion {
+	suggestions := []Suggestion{}
+	source := normalizeForSimilarity(msgid)
+	sourceLength := utf8.RuneCountInString(source)
+	for _, entry := range m.Entries {
+		candidate := normalizeForSimilarity(entry.MsgID)
+		// The length difference alone bounds the score, skip the distance when it is too low
+		candidateLength := utf8.RuneCountInString(candidate)
+		if bound := float64(min(sourceLength, candidateLength)) / float64(max(sourceLength, candidateLength, 1)); bound < minScore {
+			continue
+		}
+		score := similarity(source, candidate)
+		if score < minScore {
+			continue
+		}
+		suggestion := Suggestion{
+			Context:     entry.Context,
+			MsgID:       entry.MsgID,
+			Translation: entry.MsgStr[0],
+			Score:       math.Round(score*100) / 100,
+			File:        entry.File,
+		}
+		if len(entry.MsgStr) > 1 {
+			suggestion.PluralForms = entry.MsgStr
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].MsgID < suggestions[j].MsgID
+	})
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// Similarity scores how alike two texts are from 0 to 1, ignoring case and runs of
+// whitespace. It is one minus the edit distance relative to the longer text.
+func Similarity(a, b string) float64 {
+	return similarity(normalizeForSimilarity(a), normalizeForSimilarity(b))
+}
+
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func normalizeForSimilarity(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// levenshtein returns the number of rune insertions, deletions and substitutions
+// turning a into b
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, Similarity("Delete file", "Delete file"))
+	assert.Equal(t, 1.0, Similarity("Delete  File", "delete file"))
+	assert.InDelta(t, 0.92, Similarity("Delete file", "Delete files"), 0.01)
+	assert.Less(t, Similarity("Delete file", "Open settings"), 0.5)
+	assert.Equal(t, 0.0, Similarity("abc", ""))
+}
+
+func TestTranslationMemorySuggest(t *testing.T) {
+	memory := NewTranslationMemory("de")
+	memory.AddCatalog("admin.po", parseTestCatalogFile(t, "admin.po", `msgid "Delete file"
+msgstr "Datei löschen"
+
+msgid "Delete folder"
+msgstr "Ordner löschen"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Datei"
+msgstr[1] "%d Dateien"
+
+#, fuzzy
+msgid "Delete files"
+msgstr "Dateien löschen"
+
+msgid "Untranslated"
+msgstr ""
+`).Catalog)
+	memory.AddCatalog("web.po", parseTestCatalogFile(t, "web.po", `msgid "Delete file"
+msgstr "Datei löschen"
+
+msgid "Open settings"
+msgstr "Einstellungen öffnen"
+`).Catalog)
+
+	// Identical translations of another file are kept once
+	assert.Equal(t, []string{"admin.po", "web.po"}, memory.Files)
+	assert.Len(t, memory.Entries, 4)
+
+	suggestions := memory.Suggest("Delete files", 5, DefaultMinScore)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, "Delete file", suggestions[0].MsgID)
+	assert.Equal(t, "Datei löschen", suggestions[0].Translation)
+	assert.Equal(t, "admin.po", suggestions[0].File)
+	assert.Equal(t, 0.92, suggestions[0].Score)
+	assert.Equal(t, "Delete folder", suggestions[1].MsgID)
+
+	t.Run("Limit", func(t *testing.T) {
+		assert.Len(t, memory.Suggest("Delete files", 1, DefaultMinScore), 1)
+	})
+
+	t.Run("Min Score", func(t *testing.T) {
+		assert.Empty(t, memory.Suggest("Delete files", 5, 0.95))
+	})
+
+	t.Run("Plural Forms", func(t *testing.T) {
+		suggestions := memory.Suggest("%d files", 1, DefaultMinScore)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, "%d file", suggestions[0].MsgID)
+		assert.Equal(t, []string{"%d Datei", "%d Dateien"}, suggestions[0].PluralForms)
+	})
+}
+
+func TestBuildTranslationMemory(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_memory_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"admin/de.po": "msgid \"\"\nmsgstr \"\"\n\"Language: de_DE\\n\"\n\nmsgid \"Save\"\nmsgstr \"Speichern\"\n",
+		"web/de.po":   "msgid \"Cancel\"\nmsgstr \"Abbrechen\"\n",
+		"web/fr.po":   "msgid \"Save\"\nmsgstr \"Enregistrer\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	memory, err := BuildTranslationMemory(tempDir, "de")
+	require.NoError(t, err)
+	assert.Len(t, memory.Files, 2)
+	assert.Len(t, memory.Entries, 2)
+
+	suggestions := memory.Suggest("Save", 5, DefaultMinScore)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "Speichern", suggestions[0].Translation)
+	assert.Equal(t, filepath.Join(tempDir, "admin/de.po"), suggestions[0].File)
+}
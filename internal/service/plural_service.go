@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// maxPluralSamples limits how many sample numbers are listed per form index
+const maxPluralSamples = 12
+
+// PluralEntryIssue describes a plural entry whose msgstr count differs from nplurals
+type PluralEntryIssue struct {
+	Context   string `json:"context,omitempty"`
+	MsgID     string `json:"msgid"`
+	FormCount int    `json:"form_count"`
+	Expected  int    `json:"expected"`
+}
+
+// PluralFormsReport is the result of checking the Plural-Forms header of a catalog
+type PluralFormsReport struct {
+	Language        string             `json:"language"`
+	Header          string             `json:"header"`
+	Parsed          *utils.PluralForms `json:"parsed,omitempty"`
+	Samples         map[int][]int      `json:"samples,omitempty"`
+	Expected        *utils.PluralForms `json:"expected,omitempty"`
+	ExpectedSamples map[int][]int      `json:"expected_samples,omitempty"`
+	MatchesExpected bool               `json:"matches_expected"`
+	Problems        []string           `json:"problems"`
+	EntryIssues     []PluralEntryIssue `json:"entry_issues"`
+}
+
+// CheckPluralForms parses and evaluates the Plural-Forms header of a catalog, compares it
+// with the embedded rules for the language and finds plural entries with the wrong number
+// of msgstr forms. An empty language uses the Language header of the catalog.
+func CheckPluralForms(catalog *utils.Catalog, language string) PluralFormsReport {
+	if language == "" {
+		language = catalog.Language()
+	}
+	report := PluralFormsReport{
+		Language:    language,
+		Header:      catalog.HeaderValue("Plural-Forms"),
+		Problems:    []string{},
+		EntryIssues: []PluralEntryIssue{},
+	}
+
+	if report.Header == "" {
+		report.Problems = append(report.Problems, "Plural-Forms header is missing")
+	} else if parsed, err := utils.ParsePluralForms(report.Header); err != nil {
+		report.Problems = append(report.Problems, err.Error())
+	} else {
+		report.Parsed = &parsed
+		report.Samples = pluralSamples(parsed)
+		for index := range report.Samples {
+			if index >= parsed.NPlurals {
+				report.Problems = append(report.Problems, fmt.Sprintf("plural expression selects form %d but nplurals is %d", index, parsed.NPlurals))
+			}
+		}
+		for index := 0; index < parsed.NPlurals; index++ {
+			if len(report.Samples[index]) == 0 {
+				report.Problems = append(report.Problems, fmt.Sprintf("form %d is never selected by the plural expression", index))
+			}
+		}
+	}
+
+	switch expected, ok := utils.LookupPluralForms(language); {
+	case language == "":
+		report.Problems = append(report.Problems, "Language header is missing, cannot compare with the expected plural forms")
+	case !ok:
+		report.Problems = append(report.Problems, fmt.Sprintf("no plural rules known for language %q", language))
+	default:
+		report.Expected = &expected
+		report.ExpectedSamples = pluralSamples(expected)
+		report.MatchesExpected = report.Parsed != nil && report.Parsed.Equivalent(expected)
+		if report.Parsed != nil && !report.MatchesExpected {
+			report.Problems = append(report.Problems, fmt.Sprintf("Plural-Forms does not match the rules for %s, expected %q", language, expected.String()))
+		}
+	}
+
+	nplurals := 0
+	if report.Parsed != nil {
+		nplurals = report.Parsed.NPlurals
+	} else if report.Expected != nil {
+		nplurals = report.Expected.NPlurals
+	}
+	if nplurals > 0 {
+		for _, entry := range catalog.ActiveEntries() {
+			if entry.PluralID == "" || len(entry.Str) == nplurals {
+				continue
+			}
+			report.EntryIssues = append(report.EntryIssues, PluralEntryIssue{
+				Context:   entry.Context,
+				MsgID:     entry.ID,
+				FormCount: len(entry.Str),
+				Expected:  nplurals,
+			})
+		}
+	}
+
+	return report
+}
+
+// pluralSamples maps each form index to the first sample numbers selecting it
+func pluralSamples(forms utils.PluralForms) map[int][]int {
+	samples, err := forms.Samples(utils.PluralSampleNumbers)
+	if err != nil {
+		return nil
+	}
+	for index, numbers := range samples {
+		if len(numbers) > maxPluralSamples {
+			samples[index] = numbers[:maxPluralSamples]
+		}
+	}
+	return samples
+}
+
+// FixPluralForms replaces the Plural-Forms header of the catalog with the expected rules
+// of the report. When the current header has the same forms in another order, the msgstr
+// forms of the plural entries are reordered to match. With the same number of forms mapped
+// differently the translations cannot be carried over, so the header is left alone while
+// plural entries are translated. It returns how many entries were reordered.
+func FixPluralForms(catalog *utils.Catalog, report PluralFormsReport) (int, error) {
+	if report.Expected == nil {
+		return 0, fmt.Errorf("no plural rules known for %s", report.Language)
+	}
+	reordered := 0
+	if parsed := report.Parsed; parsed != nil && parsed.NPlurals == report.Expected.NPlurals {
+		mapping, ok := parsed.FormMapping(*report.Expected)
+		if !ok {
+			for _, entry := range catalog.ActiveEntries() {
+				if entry.PluralID != "" && hasTranslatedForm(entry) {
+					return 0, fmt.Errorf("the forms of %q cannot be carried over to the expected rules, translate the plural entries again after changing the header", entry.ID)
+				}
+			}
+		}
+		for _, entry := range catalog.Entries {
+			if !ok || entry.PluralID == "" || len(entry.Str) != len(mapping) {
+				continue
+			}
+			forms := make([]string, len(mapping))
+			for from, to := range mapping {
+				forms[to] = entry.Str[from]
+			}
+			if !slices.Equal(forms, entry.Str) {
+				entry.Str = forms
+				reordered++
+			}
+		}
+	}
+	catalog.SetHeaderValue("Plural-Forms", report.Expected.String())
+	return reordered, nil
+}
+
+// hasTranslatedForm reports whether any msgstr form of the entry is filled in
+func hasTranslatedForm(entry *utils.CatalogEntry) bool {
+	for _, form := range entry.Str {
+		if form != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixPluralForms(t *testing.T) {
+	t.Run("Reorder Forms", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: de\n"
+"Plural-Forms: nplurals=2; plural=(n == 1 ? 1 : 0);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Dateien"
+msgstr[1] "%d Datei"
+`))
+		require.NoError(t, err)
+
+		reordered, err := FixPluralForms(catalog, CheckPluralForms(catalog, ""))
+		require.NoError(t, err)
+		assert.Equal(t, 1, reordered)
+		assert.Equal(t, "nplurals=2; plural=(n != 1);", catalog.HeaderValue("Plural-Forms"))
+		assert.Equal(t, []string{"%d Datei", "%d Dateien"}, catalog.Lookup("", "%d file").Str)
+	})
+
+	t.Run("Refuse Different Mapping", func(t *testing.T) {
+		content := `msgid ""
+msgstr ""
+"Language: lv\n"
+"Plural-Forms: nplurals=3; plural=(n%10==0 || (n%100>=11 && n%100<=19) ? 0 : n%10==1 && n%100!=11 ? 1 : 2);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d failu"
+msgstr[1] "%d fails"
+msgstr[2] "%d faili"
+`
+		catalog, err := utils.ParseCatalog([]byte(content))
+		require.NoError(t, err)
+
+		_, err = FixPluralForms(catalog, CheckPluralForms(catalog, ""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be carried over")
+		assert.Equal(t, content, string(catalog.Marshal()))
+
+		// Without translations the header is replaced
+		catalog.Lookup("", "%d file").Str = []string{"", "", ""}
+		_, err = FixPluralForms(catalog, CheckPluralForms(catalog, ""))
+		require.NoError(t, err)
+		assert.Equal(t, "nplurals=3; plural=(n%10 == 1 && n%100 != 11 ? 0 : n != 0 ? 1 : 2);", catalog.HeaderValue("Plural-Forms"))
+	})
+}
+
+func TestCheckPluralForms(t *testing.T) {
+	t.Run("Wrong Header", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: ru\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d файл"
+msgstr[1] "%d файлов"
+`))
+		require.NoError(t, err)
+
+		report := CheckPluralForms(catalog, "")
+		assert.Equal(t, "ru", report.Language)
+		require.NotNil(t, report.Parsed)
+		require.NotNil(t, report.Expected)
+		assert.Equal(t, 3, report.Expected.NPlurals)
+		assert.False(t, report.MatchesExpected)
+		assert.Equal(t, []int{1, 21, 31, 41, 51, 61, 71, 81, 91, 101, 121, 131}, report.ExpectedSamples[0])
+		assert.Equal(t, []int{0, 2, 3, 4, 5}, report.Samples[1][:5])
+		assert.Len(t, report.Problems, 1)
+		assert.Empty(t, report.EntryIssues)
+	})
+
+	t.Run("Wrong Form Count", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: pl\n"
+"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d plik"
+msgstr[1] "%d pliki"
+`))
+		require.NoError(t, err)
+
+		report := CheckPluralForms(catalog, "")
+		assert.True(t, report.MatchesExpected)
+		assert.Empty(t, report.Problems)
+		require.Len(t, report.EntryIssues, 1)
+		assert.Equal(t, 2, report.EntryIssues[0].FormCount)
+		assert.Equal(t, 3, report.EntryIssues[0].Expected)
+	})
+
+	t.Run("Missing Header And Language", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte("msgid \"Hello\"\nmsgstr \"Bonjour\"\n"))
+		require.NoError(t, err)
+
+		report := CheckPluralForms(catalog, "")
+		assert.Nil(t, report.Parsed)
+		assert.Nil(t, report.Expected)
+		assert.Len(t, report.Problems, 2)
+
+		report = CheckPluralForms(catalog, "fr")
+		require.NotNil(t, report.Expected)
+		assert.False(t, report.MatchesExpected)
+	})
+
+	t.Run("Unused Form", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: de\n"
+"Plural-Forms: nplurals=3; plural=(n != 1);\n"
+`))
+		require.NoError(t, err)
+
+		report := CheckPluralForms(catalog, "")
+		assert.Contains(t, report.Problems, "form 2 is never selected by the plural expression")
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"github.com/leonelquinteros/gotext"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
 )
 
 type UnTranslatedResult struct {
@@ -50,6 +51,34 @@ func (ps *PoService) ListAllUntranslated(limit int) UnTranslatedResult {
 	}
 }
 
+// ListUntranslated returns the untranslated messages of a catalog in file order, up to
+// the specified limit. Like ListAllUntranslated a limit of 0 means 10 and a negative
+// limit returns every untranslated message. Fuzzy messages are left out of compiled
+// catalogs, so they are listed too, with their fuzzy translation as a starting point.
+func ListUntranslated(catalog *utils.Catalog, limit int) UnTranslatedResult {
+	if limit == 0 {
+		limit = 10
+	}
+	result := make(map[string]string)
+	for _, entry := range catalog.ActiveEntries() {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		if entry.ID == "" {
+			continue
+		}
+		if !entry.IsTranslated() {
+			result[entry.ID] = ""
+		} else if entry.IsFuzzy() {
+			result[entry.ID] = entry.Translation()
+		}
+	}
+	return UnTranslatedResult{
+		Language: catalog.Language(),
+		Terms:    result,
+	}
+}
+
 // Translate sets a translation for a given key
 func (ps *PoService) Translate(key, value string) {
 	ps.poFile.Set(key, value)
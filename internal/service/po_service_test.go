@@ -300,3 +300,11 @@ func TestIntegration(t *testing.T) {
 
 	})
 }
+
+func TestListUntranslated(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte("msgid \"Save\"\nmsgstr \"Speichern\"\n\n#, fuzzy\nmsgid \"Open\"\nmsgstr \"Offen\"\n\nmsgid \"Close\"\nmsgstr \"\"\n"))
+	require.NoError(t, err)
+
+	result := ListUntranslated(catalog, -1)
+	assert.Equal(t, map[string]string{"Open": "Offen", "Close": ""}, result.Terms)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// provenanceCommentPrefix starts the translator comment recording where a translation
+// came from, as in "# provenance: ai; client=Claude Desktop 0.9; time=2024-05-01T12:00:00Z"
+const provenanceCommentPrefix = "provenance: "
+
+// Sources of a translation
+const (
+	// SourceAI is a translation written by an agent
+	SourceAI = "ai"
+	// SourceHuman is a translation written by a person
+	SourceHuman = "human"
+	// SourceTM is a translation taken from the translation memory
+	SourceTM = "tm"
+	// SourceImport is a translation imported from another catalog or tool
+	SourceImport = "import"
+)
+
+// ProvenanceSources are the valid sources of a translation
+var ProvenanceSources = []string{SourceAI, SourceHuman, SourceTM, SourceImport}
+
+// Provenance tells where a translation came from and when it was written
+type Provenance struct {
+	Source string    `json:"source"`
+	Client string    `json:"client,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// IsProvenanceSource reports whether source is one of ProvenanceSources
+func IsProvenanceSource(source string) bool {
+	return slices.Contains(ProvenanceSources, source)
+}
+
+// GetProvenance returns the provenance recorded on an entry, or nil when it has none
+func GetProvenance(entry *utils.CatalogEntry) *Provenance {
+	for _, comment := range entry.TranslatorComments {
+		text, ok := strings.CutPrefix(comment, provenanceCommentPrefix)
+		if !ok {
+			continue
+		}
+		fields := strings.Split(text, "; ")
+		provenance := &Provenance{Source: strings.TrimSpace(fields[0])}
+		for _, field := range fields[1:] {
+			key, value, _ := strings.Cut(field, "=")
+			switch key {
+			case "client":
+				provenance.Client = value
+			case "time":
+				provenance.Time, _ = time.Parse(time.RFC3339, value)
+			}
+		}
+		return provenance
+	}
+	return nil
+}
+
+// SetProvenance records the provenance of the translation of an entry, replacing the
+// provenance recorded before
+func SetProvenance(entry *utils.CatalogEntry, provenance Provenance) error {
+	if !IsProvenanceSource(provenance.Source) {
+		return fmt.Errorf("unknown source %q, use one of %s", provenance.Source, strings.Join(ProvenanceSources, ", "))
+	}
+	text := provenanceCommentPrefix + provenance.Source
+	if provenance.Client != "" {
+		// The fields are separated by "; " on a single comment line
+		client := strings.ReplaceAll(strings.Join(strings.Fields(provenance.Client), " "), ";", ",")
+		text += "; client=" + client
+	}
+	if !provenance.Time.IsZero() {
+		text += "; time=" + provenance.Time.UTC().Format(time.RFC3339)
+	}
+
+	entry.TranslatorComments = slices.DeleteFunc(entry.TranslatorComments, func(c string) bool {
+		return strings.HasPrefix(c, provenanceCommentPrefix)
+	})
+	entry.TranslatorComments = append(entry.TranslatorComments, text)
+	return nil
+}
+
+// MarkProvenance records the provenance of the entries changed by applied translations
+func MarkProvenance(catalog *utils.Catalog, applied []AppliedTranslation, provenance Provenance) error {
+	for _, change := range applied {
+		if entry := catalog.Lookup(change.Context, change.MsgID); entry != nil {
+			if err := SetProvenance(entry, provenance); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
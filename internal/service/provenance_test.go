@@ -0,0 +1,53 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvenance(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(`# Keep this comment
+# provenance: human; client=poedit; time=2024-05-01T12:00:00Z
+msgid "Save"
+msgstr "Speichern"
+
+msgid "Open"
+msgstr ""
+`))
+	require.NoError(t, err)
+
+	save := catalog.Lookup("", "Save")
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, &Provenance{Source: SourceHuman, Client: "poedit", Time: at}, GetProvenance(save))
+	assert.Nil(t, GetProvenance(catalog.Lookup("", "Open")))
+
+	t.Run("Replace Provenance", func(t *testing.T) {
+		later := at.Add(time.Hour)
+		require.NoError(t, SetProvenance(save, Provenance{Source: SourceAI, Client: "Editor; beta\n1.0", Time: later}))
+		assert.Equal(t, []string{"Keep this comment", "provenance: ai; client=Editor, beta 1.0; time=2024-05-01T13:00:00Z"}, save.TranslatorComments)
+		assert.Equal(t, &Provenance{Source: SourceAI, Client: "Editor, beta 1.0", Time: later}, GetProvenance(save))
+	})
+
+	t.Run("Unknown Source", func(t *testing.T) {
+		assert.Error(t, SetProvenance(save, Provenance{Source: "robot"}))
+	})
+
+	t.Run("Mark Applied And Revert", func(t *testing.T) {
+		outcome := ApplyTranslations(catalog, map[string]string{"Open": "Öffnen"}, &Constraints{}, &Glossary{}, false)
+		require.NoError(t, MarkProvenance(catalog, outcome.Applied, Provenance{Source: SourceTM, Time: at}))
+		open := catalog.Lookup("", "Open")
+		assert.Equal(t, []string{"provenance: tm; time=2024-05-01T12:00:00Z"}, open.TranslatorComments)
+
+		// The journal keeps the comments so a revert drops the provenance again
+		changes := TranslationJournalEntries(catalog, outcome.Applied, "de.po", "translate", "s", "")
+		require.Len(t, changes, 1)
+		assert.Equal(t, open.TranslatorComments, changes[0].NewComments)
+		require.NotNil(t, ApplyRevert(catalog, PlanRevert(changes)[0], false))
+		assert.Empty(t, open.TranslatorComments)
+		assert.Nil(t, GetProvenance(open))
+	})
+}
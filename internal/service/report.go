@@ -0,0 +1,361 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// Report formats
+const (
+	ReportMarkdown = "markdown"
+	ReportHTML     = "html"
+	ReportJSON     = "json"
+)
+
+// ReportFormats are the formats a report can be rendered in
+var ReportFormats = []string{ReportMarkdown, ReportHTML, ReportJSON}
+
+// potDateLayout is the layout of the POT-Creation-Date and PO-Revision-Date headers
+const potDateLayout = "2006-01-02 15:04-0700"
+
+// ReportCell is the progress of the catalog of one domain in one language
+type ReportCell struct {
+	Language string `json:"language"`
+	Domain   string `json:"domain"`
+	File     string `json:"file"`
+	Statistics
+}
+
+// Sources of the date of an untranslated entry
+const (
+	// DatedByJournal is the time of the last change to the entry recorded in the journal
+	DatedByJournal = "journal"
+	// DatedByProvenance is the time the entry was last translated
+	DatedByProvenance = "provenance"
+	// DatedByFile is the POT-Creation-Date header of the file, or its modification time
+	DatedByFile = "file"
+)
+
+// UntranslatedItem is an untranslated entry and the time since which it is waiting for
+// a translation
+type UntranslatedItem struct {
+	Language string    `json:"language"`
+	Domain   string    `json:"domain"`
+	File     string    `json:"file"`
+	Context  string    `json:"context,omitempty"`
+	MsgID    string    `json:"msgid"`
+	Since    time.Time `json:"since"`
+	// DatedBy tells where Since comes from, such as DatedByJournal
+	DatedBy string `json:"dated_by"`
+}
+
+// Report is the completion matrix of the catalogs of a directory by language and domain
+type Report struct {
+	Directory string               `json:"directory"`
+	Generated time.Time            `json:"generated"`
+	Languages []LanguageStatistics `json:"languages"`
+	Domains   []string             `json:"domains"`
+	Cells     []ReportCell         `json:"cells"`
+	Total     Statistics           `json:"total"`
+	// OldestUntranslated are dated by the last journaled change of the entry, else by
+	// its provenance, else by the POT-Creation-Date header or modification time of its file
+	OldestUntranslated []UntranslatedItem `json:"oldest_untranslated"`
+	Skipped            []string           `json:"skipped,omitempty"`
+}
+
+// IsReportFormat reports whether format is one of ReportFormats
+func IsReportFormat(format string) bool {
+	return slices.Contains(ReportFormats, format)
+}
+
+// BuildReport scans directory for .po files and builds their completion report, listing
+// up to oldest untranslated entries
+func BuildReport(directory string, oldest int) (*Report, error) {
+	files, skipped, err := ReadCatalogFiles(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Directory:          directory,
+		Generated:          time.Now().UTC(),
+		Domains:            []string{},
+		Cells:              []ReportCell{},
+		OldestUntranslated: []UntranslatedItem{},
+		Skipped:            skipped,
+	}
+
+	_, report.Languages = CollectStatistics(files)
+	for _, language := range report.Languages {
+		report.Total.Add(language.Statistics)
+	}
+
+	var untranslated []UntranslatedItem
+	changed := journalDates(directory)
+	for _, file := range files {
+		domain := reportDomain(directory, file.Path)
+		if !slices.Contains(report.Domains, domain) {
+			report.Domains = append(report.Domains, domain)
+		}
+		report.Cells = append(report.Cells, ReportCell{
+			Language:   file.Language,
+			Domain:     domain,
+			File:       file.Path,
+			Statistics: CatalogStatistics(file.Catalog),
+		})
+
+		fileDate := catalogDate(file)
+		for _, entry := range file.Catalog.ActiveEntries() {
+			if entry.ID != "" && !entry.IsTranslated() {
+				item := UntranslatedItem{
+					Language: file.Language,
+					Domain:   domain,
+					File:     file.Path,
+					Context:  entry.Context,
+					MsgID:    entry.ID,
+					Since:    fileDate,
+					DatedBy:  DatedByFile,
+				}
+				if date, ok := changed[journalKey(absPath(file.Path), entry.Context, entry.ID)]; ok {
+					item.Since, item.DatedBy = date.UTC(), DatedByJournal
+				} else if provenance := GetProvenance(entry); provenance != nil && !provenance.Time.IsZero() {
+					item.Since, item.DatedBy = provenance.Time.UTC(), DatedByProvenance
+				}
+				untranslated = append(untranslated, item)
+			}
+		}
+	}
+	sort.Strings(report.Domains)
+
+	sort.SliceStable(untranslated, func(i, j int) bool { return untranslated[i].Since.Before(untranslated[j].Since) })
+	if oldest >= 0 && len(untranslated) > oldest {
+		untranslated = untranslated[:oldest]
+	}
+	if untranslated != nil {
+		report.OldestUntranslated = untranslated
+	}
+	return report, nil
+}
+
+// reportDomain names the domain of a file relative to the report directory
+func reportDomain(directory, path string) string {
+	domain := utils.CatalogDomain(path)
+	if rel, err := filepath.Rel(directory, domain); err == nil {
+		domain = rel
+	}
+	return filepath.ToSlash(domain)
+}
+
+// journalDates returns the time of the last journaled change of each entry of the
+// catalogs under directory, keyed by journalKey. A journal that cannot be read leaves
+// the entries dated by their file.
+func journalDates(directory string) map[string]time.Time {
+	dates := make(map[string]time.Time)
+	journal, err := OpenJournal(utils.FindProjectRoot(directory))
+	if err != nil {
+		return dates
+	}
+	entries, err := journal.Entries(JournalFilter{Path: directory})
+	if err != nil {
+		return dates
+	}
+	for _, entry := range entries {
+		key := journalKey(entry.File, entry.Context, entry.MsgID)
+		if entry.Time.After(dates[key]) {
+			dates[key] = entry.Time
+		}
+	}
+	return dates
+}
+
+// journalKey identifies an entry of a file in journalDates
+func journalKey(file, context, msgid string) string {
+	return file + "\x00" + utils.EntryKey(context, msgid)
+}
+
+// catalogDate returns when the strings of a catalog were extracted, falling back to the
+// modification time of the file
+func catalogDate(file CatalogFile) time.Time {
+	if date, err := time.Parse(potDateLayout, file.Catalog.HeaderValue("POT-Creation-Date")); err == nil {
+		return date.UTC()
+	}
+	if info, err := os.Stat(file.Path); err == nil {
+		return info.ModTime().UTC()
+	}
+	return time.Time{}
+}
+
+// Cell returns the progress of a domain in a language, or nil when the language has no
+// catalog for the domain
+func (r *Report) Cell(language, domain string) *ReportCell {
+	for i := range r.Cells {
+		if r.Cells[i].Language == language && r.Cells[i].Domain == domain {
+			return &r.Cells[i]
+		}
+	}
+	return nil
+}
+
+// Render renders the report in one of ReportFormats
+func (r *Report) Render(format string) (string, error) {
+	switch format {
+	case ReportMarkdown:
+		return r.Markdown(), nil
+	case ReportHTML:
+		return r.HTML()
+	case ReportJSON:
+		content, err := json.MarshalIndent(r, "", "  ")
+		return string(content), err
+	}
+	return "", fmt.Errorf("unknown report format %q, use one of %s", format, strings.Join(ReportFormats, ", "))
+}
+
+// Markdown renders the report as Markdown tables with text completion bars
+func (r *Report) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Translation Report\n\n")
+	fmt.Fprintf(&b, "Directory: `%s`  \nGenerated: %s\n\n", r.Directory, r.Generated.Format(time.RFC3339))
+	fmt.Fprintf(&b, "**Total:** %s %.1f%% of %d entries translated, %d fuzzy, %d untranslated\n\n",
+		progressBar(r.Total.TranslatedPercent), r.Total.TranslatedPercent, r.Total.Total, r.Total.Fuzzy, r.Total.Untranslated)
+
+	b.WriteString("## Languages\n\n")
+	b.WriteString("| Language | Progress | Translated | Fuzzy | Untranslated | Remaining words |\n")
+	b.WriteString("|---|---|---:|---:|---:|---:|\n")
+	for _, language := range r.Languages {
+		fmt.Fprintf(&b, "| %s | %s %.1f%% | %d | %d | %d | %d |\n", language.Language, progressBar(language.TranslatedPercent),
+			language.TranslatedPercent, language.Translated, language.Fuzzy, language.Untranslated, language.RemainingSourceWords)
+	}
+
+	b.WriteString("\n## Completion by Domain\n\n")
+	b.WriteString("| Language |")
+	for _, domain := range r.Domains {
+		fmt.Fprintf(&b, " `%s` |", domain)
+	}
+	b.WriteString("\n|---|" + strings.Repeat("---|", len(r.Domains)) + "\n")
+	for _, language := range r.Languages {
+		fmt.Fprintf(&b, "| %s |", language.Language)
+		for _, domain := range r.Domains {
+			cell := r.Cell(language.Language, domain)
+			if cell == nil {
+				b.WriteString(" — |")
+				continue
+			}
+			fmt.Fprintf(&b, " %s %.1f%%", progressBar(cell.TranslatedPercent), cell.TranslatedPercent)
+			if cell.Fuzzy > 0 {
+				fmt.Fprintf(&b, " (%d fuzzy)", cell.Fuzzy)
+			}
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n## Oldest Untranslated Entries\n\n")
+	if len(r.OldestUntranslated) == 0 {
+		b.WriteString("Every entry is translated.\n")
+	} else {
+		b.WriteString("| Since | Language | Domain | msgid |\n")
+		b.WriteString("|---|---|---|---|\n")
+		for _, item := range r.OldestUntranslated {
+			fmt.Fprintf(&b, "| %s | %s | `%s` | %s |\n", item.Since.Format(time.DateOnly), item.Language, item.Domain, markdownCell(item.MsgID))
+		}
+	}
+
+	if len(r.Skipped) > 0 {
+		b.WriteString("\n## Skipped Files\n\n")
+		for _, path := range r.Skipped {
+			fmt.Fprintf(&b, "- `%s`\n", path)
+		}
+	}
+	return b.String()
+}
+
+// progressBar draws a percentage as a bar of ten blocks
+func progressBar(percent float64) string {
+	filled := min(max(int(percent/10+0.5), 0), 10)
+	return strings.Repeat("█", filled) + strings.Repeat("░", 10-filled)
+}
+
+// markdownCell escapes a value for a single Markdown table cell
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// HTML renders the report as a self-contained HTML page
+func (r *Report) HTML() (string, error) {
+	type htmlCell struct {
+		*ReportCell
+		Missing bool
+	}
+	type htmlRow struct {
+		LanguageStatistics
+		Cells []htmlCell
+	}
+	rows := make([]htmlRow, 0, len(r.Languages))
+	for _, language := range r.Languages {
+		row := htmlRow{LanguageStatistics: language}
+		for _, domain := range r.Domains {
+			cell := r.Cell(language.Language, domain)
+			row.Cells = append(row.Cells, htmlCell{ReportCell: cell, Missing: cell == nil})
+		}
+		rows = append(rows, row)
+	}
+
+	var b bytes.Buffer
+	err := reportTemplate.Execute(&b, map[string]any{"Report": r, "Rows": rows})
+	return b.String(), err
+}
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.Format(time.DateOnly) },
+	"time": func(t time.Time) string { return t.Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Translation Report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #1f2328; }
+table { border-collapse: collapse; margin-bottom: 2rem; }
+th, td { border: 1px solid #d0d7de; padding: 0.4rem 0.7rem; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+td.number { text-align: right; }
+.bar { display: inline-block; width: 8rem; height: 0.7rem; background: #eaeef2; border-radius: 0.35rem; overflow: hidden; vertical-align: middle; }
+.bar span { display: block; height: 100%; background: #2da44e; }
+.fuzzy { color: #9a6700; }
+.missing { color: #8c959f; }
+</style>
+</head>
+<body>
+<h1>Translation Report</h1>
+<p>Directory: <code>{{.Report.Directory}}</code><br>Generated: {{time .Report.Generated}}</p>
+{{with .Report.Total}}<p><strong>Total:</strong> <span class="bar"><span style="width: {{.TranslatedPercent}}%"></span></span> {{printf "%.1f" .TranslatedPercent}}% of {{.Total}} entries translated, {{.Fuzzy}} fuzzy, {{.Untranslated}} untranslated</p>{{end}}
+
+<h2>Completion by Domain</h2>
+<table>
+<tr><th>Language</th><th>Total</th>{{range .Report.Domains}}<th><code>{{.}}</code></th>{{end}}</tr>
+{{range .Rows}}<tr><th>{{.Language}}</th><td><span class="bar"><span style="width: {{.TranslatedPercent}}%"></span></span> {{printf "%.1f" .TranslatedPercent}}%{{if .Fuzzy}} <span class="fuzzy">({{.Fuzzy}} fuzzy)</span>{{end}}</td>
+{{range .Cells}}{{if .Missing}}<td class="missing">—</td>{{else}}<td title="{{.File}}"><span class="bar"><span style="width: {{.TranslatedPercent}}%"></span></span> {{printf "%.1f" .TranslatedPercent}}%{{if .Fuzzy}} <span class="fuzzy">({{.Fuzzy}} fuzzy)</span>{{end}}</td>{{end}}{{end}}</tr>
+{{end}}</table>
+
+<h2>Oldest Untranslated Entries</h2>
+{{if .Report.OldestUntranslated}}<table>
+<tr><th>Since</th><th>Language</th><th>Domain</th><th>msgid</th></tr>
+{{range .Report.OldestUntranslated}}<tr><td>{{date .Since}}</td><td>{{.Language}}</td><td><code>{{.Domain}}</code></td><td>{{.MsgID}}</td></tr>
+{{end}}</table>{{else}}<p>Every entry is translated.</p>{{end}}
+{{if .Report.Skipped}}
+<h2>Skipped Files</h2>
+<ul>{{range .Report.Skipped}}<li><code>{{.}}</code></li>{{end}}</ul>
+{{end}}</body>
+</html>
+`))
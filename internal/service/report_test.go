@@ -0,0 +1,116 @@
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildReport(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"locale/fr/LC_MESSAGES/app.po":   "msgid \"\"\nmsgstr \"\"\n\"POT-Creation-Date: 2024-01-02 10:00+0000\\n\"\n\nmsgid \"Save\"\nmsgstr \"Enregistrer\"\n\n#, fuzzy\nmsgid \"Open\"\nmsgstr \"Ouvrir\"\n\nmsgid \"Close | now\"\nmsgstr \"\"\n",
+		"locale/fr/LC_MESSAGES/admin.po": "msgid \"Users\"\nmsgstr \"Utilisateurs\"\n",
+		"locale/de/LC_MESSAGES/app.po":   "msgid \"\"\nmsgstr \"\"\n\"POT-Creation-Date: 2023-06-01 08:00+0200\\n\"\n\nmsgid \"Save\"\nmsgstr \"\"\n",
+		"templates/app.pot":              "msgid \"Save\"\nmsgstr \"\"\n",
+	}
+	for path, content := range files {
+		path = filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	report, err := BuildReport(root, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"locale/{language}/LC_MESSAGES/admin.po", "locale/{language}/LC_MESSAGES/app.po"}, report.Domains)
+	require.Len(t, report.Languages, 2)
+	assert.Equal(t, 5, report.Total.Total)
+	assert.Nil(t, report.Cell("de", "locale/{language}/LC_MESSAGES/admin.po"))
+	cell := report.Cell("fr", "locale/{language}/LC_MESSAGES/app.po")
+	require.NotNil(t, cell)
+	assert.Equal(t, 33.3, cell.TranslatedPercent)
+	assert.Equal(t, 1, cell.Fuzzy)
+
+	// The oldest catalog comes first
+	require.Len(t, report.OldestUntranslated, 2)
+	assert.Equal(t, "de", report.OldestUntranslated[0].Language)
+	assert.Equal(t, time.Date(2023, 6, 1, 6, 0, 0, 0, time.UTC), report.OldestUntranslated[0].Since)
+	assert.Equal(t, DatedByFile, report.OldestUntranslated[0].DatedBy)
+	assert.Equal(t, "Close | now", report.OldestUntranslated[1].MsgID)
+
+	t.Run("Dated Per Entry", func(t *testing.T) {
+		root := t.TempDir()
+		path := filepath.Join(root, "locale", "es", "LC_MESSAGES", "app.po")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		content := "msgid \"\"\nmsgstr \"\"\n\"POT-Creation-Date: 2022-01-01 00:00+0000\\n\"\n\n" +
+			"msgid \"Plain\"\nmsgstr \"\"\n\n" +
+			"# provenance: ai; time=2021-01-01T00:00:00Z\nmsgid \"Translated before\"\nmsgstr \"\"\n\n" +
+			"msgid \"Renamed\"\nmsgstr \"\"\n"
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+		journal, err := OpenJournal(root)
+		require.NoError(t, err)
+		require.NoError(t, journal.Append([]JournalEntry{
+			{File: path, MsgID: "Renamed", Created: true, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		}))
+
+		report, err := BuildReport(root, 10)
+		require.NoError(t, err)
+
+		// Entries of the same file are ordered by their own dates
+		require.Len(t, report.OldestUntranslated, 3)
+		var order, datedBy []string
+		for _, item := range report.OldestUntranslated {
+			order = append(order, item.MsgID)
+			datedBy = append(datedBy, item.DatedBy)
+		}
+		assert.Equal(t, []string{"Renamed", "Translated before", "Plain"}, order)
+		assert.Equal(t, []string{DatedByJournal, DatedByProvenance, DatedByFile}, datedBy)
+		assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), report.OldestUntranslated[0].Since)
+	})
+
+	t.Run("Limit Oldest", func(t *testing.T) {
+		report, err := BuildReport(root, 1)
+		require.NoError(t, err)
+		assert.Len(t, report.OldestUntranslated, 1)
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		content, err := report.Render(ReportMarkdown)
+		require.NoError(t, err)
+		assert.Contains(t, content, "| fr | ██████████ 100.0% | ███░░░░░░░ 33.3% (1 fuzzy) |\n")
+		assert.Contains(t, content, "| de | — | ░░░░░░░░░░ 0.0% |\n")
+		assert.Contains(t, content, "| 2024-01-02 | fr | `locale/{language}/LC_MESSAGES/app.po` | Close \\| now |\n")
+	})
+
+	t.Run("HTML", func(t *testing.T) {
+		content, err := report.Render(ReportHTML)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(content, "<!DOCTYPE html>"))
+		assert.Contains(t, content, "<style>")
+		assert.Contains(t, content, `<span style="width: 33.3%"></span>`)
+		assert.Contains(t, content, "(1 fuzzy)")
+		assert.NotContains(t, content, "<script")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		content, err := report.Render(ReportJSON)
+		require.NoError(t, err)
+		var decoded Report
+		require.NoError(t, json.Unmarshal([]byte(content), &decoded))
+		assert.Equal(t, report.Domains, decoded.Domains)
+		assert.Len(t, decoded.Cells, 3)
+	})
+
+	t.Run("Unknown Format", func(t *testing.T) {
+		_, err := report.Render("xml")
+		assert.Error(t, err)
+	})
+}
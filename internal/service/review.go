@@ -0,0 +1,137 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+const (
+	// reviewFlag is the flag holding the review state of an entry, as in "#, review:draft"
+	reviewFlag = "review"
+	// reviewCommentPrefix starts the translator comment left by the last review
+	reviewCommentPrefix = "review: "
+)
+
+// Review states of an entry
+const (
+	// ReviewDraft is a translation waiting for review, such as a machine translation
+	ReviewDraft = "draft"
+	// ReviewReviewed is a translation checked by a reviewer and waiting for approval
+	ReviewReviewed = "reviewed"
+	// ReviewApproved is a translation ready for release
+	ReviewApproved = "approved"
+	// ReviewRejected is a translation that has to be redone; it is marked fuzzy until approved
+	// or translated again
+	ReviewRejected = "rejected"
+)
+
+// ReviewStates are the valid review states
+var ReviewStates = []string{ReviewDraft, ReviewReviewed, ReviewApproved, ReviewRejected}
+
+// ReviewItem is an entry in a review state
+type ReviewItem struct {
+	Context     string      `json:"context,omitempty"`
+	MsgID       string      `json:"msgid"`
+	MsgIDPlural string      `json:"msgid_plural,omitempty"`
+	MsgStr      []string    `json:"msgstr"`
+	State       string      `json:"state"`
+	Comment     string      `json:"comment,omitempty"`
+	Fuzzy       bool        `json:"fuzzy,omitempty"`
+	Provenance  *Provenance `json:"provenance,omitempty"`
+}
+
+// IsReviewState reports whether state is one of ReviewStates
+func IsReviewState(state string) bool {
+	return slices.Contains(ReviewStates, state)
+}
+
+// ReviewState returns the review state of an entry, or "" when it has none
+func ReviewState(entry *utils.CatalogEntry) string {
+	state, _ := entry.FlagValue(reviewFlag)
+	return state
+}
+
+// ReviewComment returns the comment left by the last review of an entry
+func ReviewComment(entry *utils.CatalogEntry) string {
+	for _, comment := range entry.TranslatorComments {
+		if text, ok := strings.CutPrefix(comment, reviewCommentPrefix); ok {
+			return text
+		}
+	}
+	return ""
+}
+
+// SetReviewState sets the review state of an entry and replaces the comment of the
+// previous review. Rejected entries are marked fuzzy so they are not used, and approving
+// an entry clears its fuzzy flag.
+func SetReviewState(entry *utils.CatalogEntry, state, reviewer, comment string) error {
+	if !IsReviewState(state) {
+		return fmt.Errorf("unknown review state %q, use one of %s", state, strings.Join(ReviewStates, ", "))
+	}
+	entry.SetFlagValue(reviewFlag, state)
+	switch state {
+	case ReviewRejected:
+		entry.AddFlag("fuzzy")
+	case ReviewApproved:
+		entry.RemoveFlag("fuzzy")
+	}
+
+	entry.TranslatorComments = slices.DeleteFunc(entry.TranslatorComments, func(c string) bool {
+		return strings.HasPrefix(c, reviewCommentPrefix)
+	})
+	if reviewer == "" && comment == "" {
+		return nil
+	}
+	text := reviewCommentPrefix + state
+	if reviewer != "" {
+		text += " by " + reviewer
+	}
+	if comment != "" {
+		// Translator comments are single lines
+		text += ": " + strings.Join(strings.Fields(comment), " ")
+	}
+	entry.TranslatorComments = append(entry.TranslatorComments, text)
+	return nil
+}
+
+// MarkApplied sets the review state of the entries changed by applied translations.
+// An empty state leaves the entries as they are.
+func MarkApplied(catalog *utils.Catalog, applied []AppliedTranslation, state string) error {
+	if state == "" {
+		return nil
+	}
+	for _, change := range applied {
+		if entry := catalog.Lookup(change.Context, change.MsgID); entry != nil {
+			if err := SetReviewState(entry, state, "", ""); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListReview returns the entries of a catalog in one of the given review states, in
+// file order
+func ListReview(catalog *utils.Catalog, states []string) []ReviewItem {
+	items := []ReviewItem{}
+	for _, entry := range catalog.ActiveEntries() {
+		state := ReviewState(entry)
+		if state == "" || !slices.Contains(states, state) {
+			continue
+		}
+		items = append(items, ReviewItem{
+			Context:     entry.Context,
+			MsgID:       entry.ID,
+			MsgIDPlural: entry.PluralID,
+			MsgStr:      entry.Str,
+			State:       state,
+			Comment:     ReviewComment(entry),
+			Fuzzy:       entry.IsFuzzy(),
+			Provenance:  GetProvenance(entry),
+		})
+	}
+	return items
+}
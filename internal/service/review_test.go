@@ -0,0 +1,73 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewState(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(`# Keep this comment
+#, review:draft
+msgid "Save"
+msgstr "Speichern"
+
+#, fuzzy, review:draft
+msgid "Open"
+msgstr "Offen"
+
+msgid "Close"
+msgstr "Schließen"
+`))
+	require.NoError(t, err)
+
+	save := catalog.Lookup("", "Save")
+	assert.Equal(t, ReviewDraft, ReviewState(save))
+	assert.Equal(t, "", ReviewState(catalog.Lookup("", "Close")))
+
+	items := ListReview(catalog, []string{ReviewDraft})
+	require.Len(t, items, 2)
+	assert.Equal(t, "Save", items[0].MsgID)
+	assert.True(t, items[1].Fuzzy)
+
+	t.Run("Reject With Comment", func(t *testing.T) {
+		require.NoError(t, SetReviewState(save, ReviewRejected, "Anna", "Too formal,\nuse Sichern"))
+		assert.Equal(t, ReviewRejected, ReviewState(save))
+		assert.True(t, save.IsFuzzy())
+		assert.Equal(t, "rejected by Anna: Too formal, use Sichern", ReviewComment(save))
+		assert.Equal(t, []string{"Keep this comment", "review: rejected by Anna: Too formal, use Sichern"}, save.TranslatorComments)
+	})
+
+	t.Run("Approve Replaces Comment", func(t *testing.T) {
+		require.NoError(t, SetReviewState(save, ReviewApproved, "", ""))
+		assert.Equal(t, ReviewApproved, ReviewState(save))
+		assert.False(t, save.IsFuzzy())
+		assert.Equal(t, []string{"Keep this comment"}, save.TranslatorComments)
+		assert.Equal(t, []string{"review:approved"}, save.Flags)
+
+		items := ListReview(catalog, []string{ReviewApproved})
+		require.Len(t, items, 1)
+		assert.Equal(t, "Save", items[0].MsgID)
+	})
+
+	t.Run("Unknown State", func(t *testing.T) {
+		assert.Error(t, SetReviewState(save, "done", "", ""))
+	})
+
+	t.Run("Mark Applied", func(t *testing.T) {
+		outcome := ApplyTranslations(catalog, map[string]string{"Close": "Zumachen"}, &Constraints{}, &Glossary{}, false)
+		require.NoError(t, MarkApplied(catalog, outcome.Applied, ReviewDraft))
+		assert.Equal(t, ReviewDraft, ReviewState(catalog.Lookup("", "Close")))
+	})
+
+	t.Run("Retranslate Rejected", func(t *testing.T) {
+		open := catalog.Lookup("", "Open")
+		require.NoError(t, SetReviewState(open, ReviewRejected, "Anna", "Use Öffnen"))
+		outcome := ApplyTranslations(catalog, map[string]string{"Open": "Öffnen"}, &Constraints{}, &Glossary{}, false)
+		require.NoError(t, MarkApplied(catalog, outcome.Applied, ReviewDraft))
+		assert.Equal(t, ReviewDraft, ReviewState(open))
+		assert.False(t, open.IsFuzzy())
+	})
+}
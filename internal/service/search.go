@@ -0,0 +1,205 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// Search modes of SearchCatalog
+const (
+	SearchSubstring = "substring"
+	SearchExact     = "exact"
+	SearchRegex     = "regex"
+	SearchWord      = "word"
+	SearchFuzzy     = "fuzzy"
+)
+
+// DefaultFuzzyScore is the lowest similarity a fuzzy search matches by default
+const DefaultFuzzyScore = 0.7
+
+// SearchModes are the supported search modes
+var SearchModes = []string{SearchSubstring, SearchExact, SearchRegex, SearchWord, SearchFuzzy}
+
+// SearchFields are the parts of an entry that can be searched. msgid includes
+// msgid_plural, msgstr every plural form and comments both translator and
+// extracted comments.
+var SearchFields = []string{"msgid", "msgstr", "context", "comments", "references"}
+
+// SearchOptions selects how and where SearchCatalog looks for a term
+type SearchOptions struct {
+	Mode          string
+	Fields        []string
+	CaseSensitive bool
+	// MinScore is the lowest similarity a fuzzy search matches, DefaultFuzzyScore when nil
+	MinScore *float64
+}
+
+// SearchMatch is an entry matching a search together with the fields that matched
+type SearchMatch struct {
+	Context       string   `json:"context,omitempty"`
+	MsgID         string   `json:"msgid"`
+	MsgIDPlural   string   `json:"msgid_plural,omitempty"`
+	MsgStr        []string `json:"msgstr"`
+	MatchedFields []string `json:"matched_fields"`
+	// Score is the best similarity of the matched fields in fuzzy mode
+	Score float64 `json:"score,omitempty"`
+	// Provenance tells where the translation came from, when it was recorded
+	Provenance *Provenance `json:"provenance,omitempty"`
+}
+
+// matcher reports whether a value matches and how well
+type matcher func(value string) (bool, float64)
+
+// SearchCatalog returns the active entries of a catalog matching term in any of the
+// selected fields. Matches are in file order, or best first in fuzzy mode.
+func SearchCatalog(catalog *utils.Catalog, term string, options SearchOptions) ([]SearchMatch, error) {
+	match, err := newMatcher(term, options)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := searchFields(options.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []SearchMatch{}
+	for _, entry := range catalog.ActiveEntries() {
+		if entry.ID == "" {
+			continue
+		}
+		result := SearchMatch{
+			Context:       entry.Context,
+			MsgID:         entry.ID,
+			MsgIDPlural:   entry.PluralID,
+			MsgStr:        entry.Str,
+			MatchedFields: []string{},
+			Provenance:    GetProvenance(entry),
+		}
+		for _, field := range fields {
+			for _, value := range fieldValues(entry, field) {
+				ok, score := match(value)
+				if !ok {
+					continue
+				}
+				if len(result.MatchedFields) == 0 || result.MatchedFields[len(result.MatchedFields)-1] != field {
+					result.MatchedFields = append(result.MatchedFields, field)
+				}
+				result.Score = max(result.Score, score)
+			}
+		}
+		if len(result.MatchedFields) > 0 {
+			matches = append(matches, result)
+		}
+	}
+
+	if options.Mode == SearchFuzzy {
+		sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	}
+	return matches, nil
+}
+
+func searchFields(fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return []string{"msgid"}, nil
+	}
+	for _, field := range fields {
+		if !slices.Contains(SearchFields, field) {
+			return nil, fmt.Errorf("unknown field %q, expected one of %s", field, strings.Join(SearchFields, ", "))
+		}
+	}
+	return fields, nil
+}
+
+func fieldValues(entry *utils.CatalogEntry, field string) []string {
+	switch field {
+	case "msgid":
+		if entry.PluralID != "" {
+			return []string{entry.ID, entry.PluralID}
+		}
+		return []string{entry.ID}
+	case "msgstr":
+		return entry.Str
+	case "context":
+		if entry.Context != "" {
+			return []string{entry.Context}
+		}
+	case "comments":
+		return append(append([]string{}, entry.TranslatorComments...), entry.ExtractedComments...)
+	case "references":
+		var references []string
+		for _, line := range entry.References {
+			references = append(references, strings.Fields(line)...)
+		}
+		return references
+	}
+	return nil
+}
+
+func newMatcher(term string, options SearchOptions) (matcher, error) {
+	fold := func(s string) string {
+		if options.CaseSensitive {
+			return s
+		}
+		return strings.ToLower(s)
+	}
+	caseFlag := "(?i)"
+	if options.CaseSensitive {
+		caseFlag = ""
+	}
+
+	switch options.Mode {
+	case "", SearchSubstring:
+		folded := fold(term)
+		return func(value string) (bool, float64) {
+			return strings.Contains(fold(value), folded), 0
+		}, nil
+	case SearchExact:
+		folded := fold(term)
+		return func(value string) (bool, float64) {
+			return fold(value) == folded, 0
+		}, nil
+	case SearchRegex, SearchWord:
+		pattern := term
+		if options.Mode == SearchWord {
+			pattern = `(?:^|[^\p{L}\p{N}_])` + regexp.QuoteMeta(term) + `(?:$|[^\p{L}\p{N}_])`
+		}
+		re, err := regexp.Compile(caseFlag + pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return func(value string) (bool, float64) {
+			return re.MatchString(value), 0
+		}, nil
+	case SearchFuzzy:
+		minScore := DefaultFuzzyScore
+		if options.MinScore != nil {
+			minScore = *options.MinScore
+		}
+		return func(value string) (bool, float64) {
+			score := fuzzyScore(term, value)
+			return score >= minScore, math.Round(score*100) / 100
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown mode %q, expected one of %s", options.Mode, strings.Join(SearchModes, ", "))
+}
+
+// fuzzyScore is the best similarity between term and the whole value or any run of
+// as many consecutive words of value as term has, so a misspelled word is found in a
+// longer sentence
+func fuzzyScore(term, value string) float64 {
+	best := Similarity(term, value)
+	termWords := len(strings.Fields(term))
+	words := strings.Fields(value)
+	for i := 0; termWords > 0 && i+termWords <= len(words); i++ {
+		window := strings.Join(words[i:i+termWords], " ")
+		window = strings.TrimFunc(window, func(r rune) bool { return strings.ContainsRune(".,;:!?\"'()[]", r) })
+		best = max(best, Similarity(term, window))
+	}
+	return best
+}
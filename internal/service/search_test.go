@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const searchTestCatalog = `msgid ""
+msgstr ""
+"Language: de\n"
+
+#. Shown on the toolbar
+#: src/Toolbar.tsx:12
+msgid "Save"
+msgstr "Speichern"
+
+#: src/Editor.tsx:40
+msgid "Save as draft"
+msgstr "Als Entwurf speichern"
+
+# Keep it short
+#: src/Settings.tsx:8
+msgctxt "settings"
+msgid "Saved searches"
+msgstr "Gespeicherte Suchen"
+
+#: src/Files.tsx:3
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Datei"
+msgstr[1] "%d Dateien"
+
+#~ msgid "Save all"
+#~ msgstr "Alle speichern"
+`
+
+func searchMsgIDs(t *testing.T, term string, options SearchOptions) []string {
+	catalog, err := utils.ParseCatalog([]byte(searchTestCatalog))
+	require.NoError(t, err)
+	matches, err := SearchCatalog(catalog, term, options)
+	require.NoError(t, err)
+	msgids := []string{}
+	for _, match := range matches {
+		msgids = append(msgids, match.MsgID)
+	}
+	return msgids
+}
+
+func TestSearchCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		term    string
+		options SearchOptions
+		msgids  []string
+	}{
+		{"Substring", "save", SearchOptions{}, []string{"Save", "Save as draft", "Saved searches"}},
+		{"Case Sensitive", "save", SearchOptions{CaseSensitive: true}, []string{}},
+		{"Exact", "save", SearchOptions{Mode: SearchExact}, []string{"Save"}},
+		{"Word", "save", SearchOptions{Mode: SearchWord}, []string{"Save", "Save as draft"}},
+		{"Regex", `^Save(d)? \w+$`, SearchOptions{Mode: SearchRegex}, []string{"Saved searches"}},
+		{"Plural", "files", SearchOptions{Mode: SearchWord}, []string{"%d file"}},
+		{"Msgstr", "speichern", SearchOptions{Fields: []string{"msgstr"}}, []string{"Save", "Save as draft"}},
+		{"Msgstr Plural Form", "%d Dateien", SearchOptions{Mode: SearchExact, Fields: []string{"msgstr"}}, []string{"%d file"}},
+		{"Context", "settings", SearchOptions{Fields: []string{"context"}}, []string{"Saved searches"}},
+		{"Comments", "toolbar", SearchOptions{Fields: []string{"comments"}}, []string{"Save"}},
+		{"References", "src/Editor.tsx", SearchOptions{Fields: []string{"references"}}, []string{"Save as draft"}},
+		{"Fuzzy Typo", "Sve as drat", SearchOptions{Mode: SearchFuzzy}, []string{"Save as draft"}},
+		{"Obsolete Skipped", "Save all", SearchOptions{Mode: SearchExact}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.msgids, searchMsgIDs(t, tt.term, tt.options))
+		})
+	}
+}
+
+func TestSearchCatalogMatchedFields(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(searchTestCatalog))
+	require.NoError(t, err)
+
+	matches, err := SearchCatalog(catalog, "sav", SearchOptions{Fields: []string{"msgid", "msgstr", "comments"}})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	assert.Equal(t, []string{"msgid"}, matches[0].MatchedFields)
+
+	// A typo in a word of a longer translation is found and scored
+	matches, err = SearchCatalog(catalog, "Entwruf", SearchOptions{Mode: SearchFuzzy, Fields: []string{"msgid", "msgstr"}})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Save as draft", matches[0].MsgID)
+	assert.Equal(t, []string{"msgstr"}, matches[0].MatchedFields)
+	assert.Equal(t, 0.71, matches[0].Score)
+
+	t.Run("Invalid Options", func(t *testing.T) {
+		_, err := SearchCatalog(catalog, "(", SearchOptions{Mode: SearchRegex})
+		assert.ErrorContains(t, err, "invalid regular expression")
+		_, err = SearchCatalog(catalog, "x", SearchOptions{Mode: "soundex"})
+		assert.ErrorContains(t, err, "unknown mode")
+		_, err = SearchCatalog(catalog, "x", SearchOptions{Fields: []string{"flags"}})
+		assert.ErrorContains(t, err, "unknown field")
+	})
+}
+
+func TestSearchCatalogMinScore(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(searchTestCatalog))
+	require.NoError(t, err)
+
+	// An explicit 0 matches every entry instead of falling back to the default
+	zero := 0.0
+	matches, err := SearchCatalog(catalog, "Sve as drat", SearchOptions{Mode: SearchFuzzy, MinScore: &zero})
+	require.NoError(t, err)
+	assert.Len(t, matches, len(catalog.ActiveEntries()))
+
+	strict := 1.0
+	matches, err = SearchCatalog(catalog, "Sve as drat", SearchOptions{Mode: SearchFuzzy, MinScore: &strict})
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
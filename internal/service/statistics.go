@@ -0,0 +1,142 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// Statistics counts the entries of one or more catalogs. Translated, fuzzy and
+// untranslated entries add up to Total; obsolete entries are counted apart.
+type Statistics struct {
+	Total        int `json:"total"`
+	Translated   int `json:"translated"`
+	Fuzzy        int `json:"fuzzy"`
+	Untranslated int `json:"untranslated"`
+	Obsolete     int `json:"obsolete"`
+
+	TranslatedPercent   float64 `json:"translated_percent"`
+	FuzzyPercent        float64 `json:"fuzzy_percent"`
+	UntranslatedPercent float64 `json:"untranslated_percent"`
+
+	// SourceWords and SourceCharacters measure the msgids of every active entry,
+	// RemainingSourceWords only those of fuzzy and untranslated entries
+	SourceWords          int `json:"source_words"`
+	SourceCharacters     int `json:"source_characters"`
+	RemainingSourceWords int `json:"remaining_source_words"`
+	// TargetWords and TargetCharacters measure the filled in msgstr forms
+	TargetWords      int `json:"target_words"`
+	TargetCharacters int `json:"target_characters"`
+}
+
+// FileStatistics are the statistics of one catalog file
+type FileStatistics struct {
+	File     string `json:"file"`
+	Language string `json:"language"`
+	Statistics
+}
+
+// LanguageStatistics are the statistics of every file of one language
+type LanguageStatistics struct {
+	Language string `json:"language"`
+	Files    int    `json:"files"`
+	Statistics
+}
+
+// CatalogStatistics counts the entries of a catalog
+func CatalogStatistics(catalog *utils.Catalog) Statistics {
+	var stats Statistics
+	for _, entry := range catalog.Entries {
+		if entry.ID == "" {
+			continue
+		}
+		if entry.Obsolete {
+			stats.Obsolete++
+			continue
+		}
+		stats.Total++
+
+		source := entry.ID
+		if entry.PluralID != "" {
+			source += "\n" + entry.PluralID
+		}
+		words := len(strings.Fields(source))
+		stats.SourceWords += words
+		stats.SourceCharacters += utf8.RuneCountInString(entry.ID) + utf8.RuneCountInString(entry.PluralID)
+
+		switch {
+		case entry.IsFuzzy():
+			stats.Fuzzy++
+			stats.RemainingSourceWords += words
+		case entry.IsTranslated():
+			stats.Translated++
+		default:
+			stats.Untranslated++
+			stats.RemainingSourceWords += words
+		}
+		for _, msgstr := range entry.Str {
+			stats.TargetWords += len(strings.Fields(msgstr))
+			stats.TargetCharacters += utf8.RuneCountInString(msgstr)
+		}
+	}
+	stats.updatePercentages()
+	return stats
+}
+
+// Add adds the counts of other to the statistics
+func (s *Statistics) Add(other Statistics) {
+	s.Total += other.Total
+	s.Translated += other.Translated
+	s.Fuzzy += other.Fuzzy
+	s.Untranslated += other.Untranslated
+	s.Obsolete += other.Obsolete
+	s.SourceWords += other.SourceWords
+	s.SourceCharacters += other.SourceCharacters
+	s.RemainingSourceWords += other.RemainingSourceWords
+	s.TargetWords += other.TargetWords
+	s.TargetCharacters += other.TargetCharacters
+	s.updatePercentages()
+}
+
+func (s *Statistics) updatePercentages() {
+	s.TranslatedPercent = percentOf(s.Translated, s.Total)
+	s.FuzzyPercent = percentOf(s.Fuzzy, s.Total)
+	s.UntranslatedPercent = percentOf(s.Untranslated, s.Total)
+}
+
+// percentOf returns count as a percentage of total rounded to one decimal
+func percentOf(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)*1000/float64(total)) / 10
+}
+
+// CollectStatistics returns the statistics of each file and of each language, ordered
+// by language
+func CollectStatistics(files []CatalogFile) ([]FileStatistics, []LanguageStatistics) {
+	fileStats := []FileStatistics{}
+	byLanguage := map[string]*LanguageStatistics{}
+	for _, file := range files {
+		stats := CatalogStatistics(file.Catalog)
+		fileStats = append(fileStats, FileStatistics{File: file.Path, Language: file.Language, Statistics: stats})
+
+		language, ok := byLanguage[file.Language]
+		if !ok {
+			language = &LanguageStatistics{Language: file.Language}
+			byLanguage[file.Language] = language
+		}
+		language.Files++
+		language.Add(stats)
+	}
+
+	languageStats := make([]LanguageStatistics, 0, len(byLanguage))
+	for _, language := range byLanguage {
+		languageStats = append(languageStats, *language)
+	}
+	sort.Slice(languageStats, func(i, j int) bool { return languageStats[i].Language < languageStats[j].Language })
+	return fileStats, languageStats
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogStatistics(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: fr\n"
+
+msgid "Save changes"
+msgstr "Enregistrer les modifications"
+
+#, fuzzy
+msgid "Open file"
+msgstr "Ouvrir"
+
+msgid "Close"
+msgstr ""
+
+msgid "One file"
+msgid_plural "Many files"
+msgstr[0] "Un fichier"
+msgstr[1] ""
+
+#~ msgid "Old"
+#~ msgstr "Vieux"
+`))
+	require.NoError(t, err)
+
+	stats := CatalogStatistics(catalog)
+	assert.Equal(t, Statistics{
+		Total:                4,
+		Translated:           1,
+		Fuzzy:                1,
+		Untranslated:         2,
+		Obsolete:             1,
+		TranslatedPercent:    25,
+		FuzzyPercent:         25,
+		UntranslatedPercent:  50,
+		SourceWords:          9,
+		SourceCharacters:     44,
+		RemainingSourceWords: 7,
+		TargetWords:          6,
+		TargetCharacters:     45,
+	}, stats)
+
+	t.Run("Aggregate By Language", func(t *testing.T) {
+		empty := utils.NewCatalog()
+		files, languages := CollectStatistics([]CatalogFile{
+			{Path: "fr/app.po", Language: "fr", Catalog: catalog},
+			{Path: "de/app.po", Language: "de", Catalog: empty},
+			{Path: "fr/admin.po", Language: "fr", Catalog: catalog},
+		})
+		require.Len(t, files, 3)
+		assert.Equal(t, "fr/app.po", files[0].File)
+
+		require.Len(t, languages, 2)
+		assert.Equal(t, "de", languages[0].Language)
+		assert.Equal(t, 0, languages[0].Total)
+		assert.Equal(t, 0.0, languages[0].TranslatedPercent)
+		assert.Equal(t, "fr", languages[1].Language)
+		assert.Equal(t, 2, languages[1].Files)
+		assert.Equal(t, 8, languages[1].Total)
+		assert.Equal(t, 25.0, languages[1].TranslatedPercent)
+	})
+
+	t.Run("Rounded Percentages", func(t *testing.T) {
+		assert.Equal(t, 33.3, percentOf(1, 3))
+		assert.Equal(t, 66.7, percentOf(2, 3))
+	})
+}
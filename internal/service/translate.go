@@ -0,0 +1,119 @@
+package service
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// Changes a translation makes to its entry
+const (
+	// ChangeNew translates an entry that had no translation, or only a fuzzy one
+	ChangeNew = "new"
+	// ChangeChanged replaces an existing translation
+	ChangeChanged = "changed"
+	// ChangeUnchanged sets the translation the entry already had
+	ChangeUnchanged = "unchanged"
+	// ChangeUnknown adds an entry for a msgid the catalog does not contain
+	ChangeUnknown = "unknown"
+	// ChangeRejected leaves the entry as is because validation failed
+	ChangeRejected = "rejected"
+)
+
+// TranslationOutcome is the result of applying a set of translations to a catalog
+type TranslationOutcome struct {
+	TranslatedCount int               `json:"translated_count"`
+	Rejected        []string          `json:"rejected"`
+	Issues          []ValidationIssue `json:"issues"`
+	// Changes maps each msgid to the change its translation makes, such as ChangeNew
+	Changes map[string]string `json:"changes"`
+	// Applied lists the translations that modified the catalog, in msgid order
+	Applied []AppliedTranslation `json:"-"`
+}
+
+// AppliedTranslation is a translation that modified an entry of the catalog
+type AppliedTranslation struct {
+	Context  string
+	MsgID    string
+	OldValue []string
+	NewValue []string
+	OldFlags []string
+	// OldComments are the translator comments of the entry before the change
+	OldComments []string
+	// Created is set when the entry was added to the catalog
+	Created bool
+}
+
+// ApplyTranslations sets the translations of a catalog, keyed by msgid, after checking
+// them for markup, length, glossary and charset problems. Translations with errors are
+// rejected unless force is set; characters the charset of the catalog cannot represent
+// are always rejected since the file could not be written. Applying a translation
+// clears the fuzzy flag and previous msgid of the entry, since it has been translated
+// against the current msgid.
+func ApplyTranslations(catalog *utils.Catalog, translations map[string]string, constraints *Constraints, glossary *Glossary, force bool) TranslationOutcome {
+	outcome := TranslationOutcome{
+		Rejected: []string{},
+		Issues:   []ValidationIssue{},
+		Changes:  make(map[string]string, len(translations)),
+	}
+
+	keys := make([]string, 0, len(translations))
+	for key := range translations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := translations[key]
+		entry := catalog.Lookup("", key)
+		change := entryChange(entry, value)
+		if entry == nil {
+			entry = &utils.CatalogEntry{ID: key}
+		}
+		charsetIssues := CheckCharset("", key, value, catalog.Charset())
+		keyIssues := append(charsetIssues, ValidateTranslation("", key, value)...)
+		keyIssues = append(keyIssues, CheckLength("", key, value, constraints.ForEntry(entry))...)
+		keyIssues = append(keyIssues, glossary.CheckGlossary(catalog.Language(), "", key, value)...)
+		outcome.Issues = append(outcome.Issues, keyIssues...)
+		if len(charsetIssues) > 0 || HasErrors(keyIssues) && !force {
+			outcome.Rejected = append(outcome.Rejected, key)
+			outcome.Changes[key] = ChangeRejected
+			continue
+		}
+		var oldValue, oldFlags, oldComments []string
+		if change != ChangeUnknown {
+			oldValue, oldFlags, oldComments = slices.Clone(entry.Str), slices.Clone(entry.Flags), slices.Clone(entry.TranslatorComments)
+		}
+		updated := catalog.Set("", key, value)
+		updated.RemoveFlag("fuzzy")
+		updated.PreviousContext, updated.PreviousID, updated.PreviousPluralID = "", "", ""
+		outcome.TranslatedCount++
+		outcome.Changes[key] = change
+		if change != ChangeUnchanged {
+			outcome.Applied = append(outcome.Applied, AppliedTranslation{
+				Context:     updated.Context,
+				MsgID:       key,
+				OldValue:    oldValue,
+				NewValue:    slices.Clone(updated.Str),
+				OldFlags:    oldFlags,
+				OldComments: oldComments,
+				Created:     change == ChangeUnknown,
+			})
+		}
+	}
+	return outcome
+}
+
+// entryChange tells which change setting value as the translation of entry makes
+func entryChange(entry *utils.CatalogEntry, value string) string {
+	switch {
+	case entry == nil:
+		return ChangeUnknown
+	case !entry.IsTranslated() || entry.IsFuzzy():
+		return ChangeNew
+	case entry.Translation() == value:
+		return ChangeUnchanged
+	}
+	return ChangeChanged
+}
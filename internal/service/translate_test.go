@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyTranslations(t *testing.T) {
+	content := "msgid \"Save\"\nmsgstr \"\"\n\nmsgid \"Click <b>here</b>\"\nmsgstr \"\"\n"
+	translations := map[string]string{
+		"Save":              "Speichern",
+		"Click <b>here</b>": "Hier <b>klicken",
+		"New":               "Neu",
+	}
+
+	t.Run("Reject Errors", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte(content))
+		require.NoError(t, err)
+
+		outcome := ApplyTranslations(catalog, translations, &Constraints{}, &Glossary{}, false)
+		assert.Equal(t, 2, outcome.TranslatedCount)
+		assert.Equal(t, []string{"Click <b>here</b>"}, outcome.Rejected)
+		assert.NotEmpty(t, outcome.Issues)
+		assert.Equal(t, []string{"Speichern"}, catalog.Lookup("", "Save").Str)
+		assert.Equal(t, []string{"Neu"}, catalog.Lookup("", "New").Str)
+		assert.False(t, catalog.Lookup("", "Click <b>here</b>").IsTranslated())
+		assert.Equal(t, map[string]string{
+			"Save":              ChangeNew,
+			"Click <b>here</b>": ChangeRejected,
+			"New":               ChangeUnknown,
+		}, outcome.Changes)
+	})
+
+	t.Run("Force", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte(content))
+		require.NoError(t, err)
+
+		outcome := ApplyTranslations(catalog, translations, &Constraints{}, &Glossary{}, true)
+		assert.Equal(t, 3, outcome.TranslatedCount)
+		assert.Empty(t, outcome.Rejected)
+		assert.NotEmpty(t, outcome.Issues)
+		assert.Equal(t, []string{"Hier <b>klicken"}, catalog.Lookup("", "Click <b>here</b>").Str)
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte("msgid \"Save\"\nmsgstr \"Speichern\"\n\nmsgid \"Open\"\nmsgstr \"Offen\"\n"))
+		require.NoError(t, err)
+
+		outcome := ApplyTranslations(catalog, map[string]string{"Save": "Speichern", "Open": "Öffnen"}, &Constraints{}, &Glossary{}, false)
+		assert.Equal(t, map[string]string{"Save": ChangeUnchanged, "Open": ChangeChanged}, outcome.Changes)
+	})
+
+	t.Run("Clear Fuzzy", func(t *testing.T) {
+		catalog, err := utils.ParseCatalog([]byte("#, fuzzy, c-format\n#| msgid \"Save\"\nmsgid \"Save file\"\nmsgstr \"Speichern\"\n"))
+		require.NoError(t, err)
+
+		outcome := ApplyTranslations(catalog, map[string]string{"Save file": "Speichern"}, &Constraints{}, &Glossary{}, false)
+		assert.Equal(t, map[string]string{"Save file": ChangeNew}, outcome.Changes)
+		require.Len(t, outcome.Applied, 1)
+		assert.Equal(t, []string{"fuzzy", "c-format"}, outcome.Applied[0].OldFlags)
+		assert.Equal(t, "#, c-format\nmsgid \"Save file\"\nmsgstr \"Speichern\"\n", string(catalog.Marshal()))
+	})
+}
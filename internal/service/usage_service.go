@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// OrphanedEntry is a catalog entry that is no longer referenced by the source tree
+type OrphanedEntry struct {
+	Context    string   `json:"context,omitempty"`
+	MsgID      string   `json:"msgid"`
+	References []string `json:"references,omitempty"`
+}
+
+// KeyUsageReport is the result of cross-checking a catalog against its source tree
+type KeyUsageReport struct {
+	Orphaned []OrphanedEntry          `json:"orphaned"`
+	Missing  []utils.ExtractedMessage `json:"missing"`
+}
+
+// CheckKeyUsage reports catalog entries that no source file uses anymore and
+// source strings that are missing from the catalog. The files were read from sourceDir;
+// "#:" references are resolved against sourceDir and then against the other roots.
+// An entry counts as used when the extractor finds it, when the msgid appears as a
+// quoted string literal in any source file, or when one of its references points to a
+// scanned file containing the literal or to an existing file that was not scanned, such
+// as a file in another language.
+func CheckKeyUsage(catalog *utils.Catalog, sourceDir string, files []utils.SourceFile, opts utils.ExtractOptions, roots ...string) KeyUsageReport {
+	messages := utils.ExtractFromSourceFiles(files, opts)
+
+	extracted := make(map[string]bool, len(messages))
+	for _, msg := range messages {
+		extracted[utils.EntryKey(msg.Context, msg.ID)] = true
+	}
+
+	scanned := make(map[string][]byte, len(files))
+	for _, file := range files {
+		scanned[filepath.Join(sourceDir, filepath.FromSlash(file.Path))] = file.Content
+	}
+	roots = append([]string{sourceDir}, roots...)
+
+	report := KeyUsageReport{
+		Orphaned: []OrphanedEntry{},
+		Missing:  []utils.ExtractedMessage{},
+	}
+
+	for _, entry := range catalog.ActiveEntries() {
+		if extracted[entry.Key()] || foundInFiles(entry.ID, files) || referencedByFiles(entry, scanned, roots) {
+			continue
+		}
+		report.Orphaned = append(report.Orphaned, OrphanedEntry{
+			Context:    entry.Context,
+			MsgID:      entry.ID,
+			References: entry.References,
+		})
+	}
+
+	for _, msg := range messages {
+		if catalog.Lookup(msg.Context, msg.ID) == nil {
+			report.Missing = append(report.Missing, msg)
+		}
+	}
+
+	return report
+}
+
+// MarkObsolete turns the given entries into obsolete (#~) entries at the end of the catalog
+// and returns how many entries were changed
+func MarkObsolete(catalog *utils.Catalog, orphaned []OrphanedEntry) int {
+	orphanKeys := make(map[string]bool, len(orphaned))
+	for _, o := range orphaned {
+		orphanKeys[utils.EntryKey(o.Context, o.MsgID)] = true
+	}
+
+	var active, obsolete []*utils.CatalogEntry
+	count := 0
+	for _, entry := range catalog.Entries {
+		if !entry.Obsolete && orphanKeys[entry.Key()] {
+			entry.Obsolete = true
+			entry.References = nil
+			entry.ExtractedComments = nil
+			count++
+		}
+		if entry.Obsolete {
+			obsolete = append(obsolete, entry)
+		} else {
+			active = append(active, entry)
+		}
+	}
+	catalog.Entries = append(active, obsolete...)
+
+	return count
+}
+
+// referencedByFiles reports whether a "#:" reference of the entry points to a scanned
+// file that still contains the msgid, or to an existing file that was not scanned and
+// so cannot tell whether the entry is still used
+func referencedByFiles(entry *utils.CatalogEntry, scanned map[string][]byte, roots []string) bool {
+	literals := msgIDLiterals(entry.ID)
+	for _, ref := range entry.References {
+		refPath, _ := utils.SplitReference(ref)
+		for _, root := range roots {
+			path := filepath.Join(root, filepath.FromSlash(refPath))
+			if content, ok := scanned[path]; ok {
+				if containsLiteral(content, literals) {
+					return true
+				}
+				continue
+			}
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// foundInFiles reports whether a source file contains the msgid as a string literal
+func foundInFiles(msgid string, files []utils.SourceFile) bool {
+	literals := msgIDLiterals(msgid)
+	for _, file := range files {
+		if containsLiteral(file.Content, literals) {
+			return true
+		}
+	}
+	return false
+}
+
+// msgIDLiterals returns the ways a msgid can be written as a JS string literal: in single
+// quotes, double quotes or backticks, with the quote, backslashes and control characters
+// escaped. Template literals may also hold newlines and tabs verbatim.
+func msgIDLiterals(msgid string) []string {
+	if msgid == "" {
+		return nil
+	}
+	var literals []string
+	for _, quote := range []string{"'", "\"", "`"} {
+		escaped := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\t", "\\t", "\r", "\\r", quote, "\\"+quote).Replace(msgid)
+		literals = append(literals, quote+escaped+quote)
+	}
+	if strings.ContainsAny(msgid, "\n\t") {
+		raw := strings.NewReplacer("\\", "\\\\", "`", "\\`").Replace(msgid)
+		literals = append(literals, "`"+raw+"`")
+	}
+	return literals
+}
+
+// containsLiteral reports whether content contains one of the literals
+func containsLiteral(content []byte, literals []string) bool {
+	for _, literal := range literals {
+		if bytes.Contains(content, []byte(literal)) {
+			return true
+		}
+	}
+	return false
+}
+
+// SourceUsage is a window of source code around one place where a msgid is used
+type SourceUsage struct {
+	Reference string `json:"reference,omitempty"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+	Snippet   string `json:"snippet"`
+	// Source tells whether the usage came from a "#:" reference or from searching the tree
+	Source string `json:"source"`
+}
+
+// maxSearchUsages caps the number of usages returned by the source tree search
+const maxSearchUsages = 20
+
+// FindUsages resolves the "#:" references of a msgid relative to the project root and returns
+// the surrounding source lines of each usage. References that do not exist under the root are
+// looked up by path suffix; when no reference resolves, the source tree is searched for the msgid.
+func FindUsages(projectRoot string, references []string, msgid string, extensions []string, contextLines int) ([]SourceUsage, error) {
+	usages := []SourceUsage{}
+
+	for _, ref := range references {
+		refPath, line := utils.SplitReference(ref)
+
+		filePath := filepath.Join(projectRoot, filepath.FromSlash(refPath))
+		if _, err := os.Stat(filePath); err != nil {
+			filePath, err = utils.FindFileBySuffix(projectRoot, refPath)
+			if err != nil {
+				return nil, err
+			}
+			if filePath == "" {
+				continue
+			}
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		lines := strings.Split(string(content), "\n")
+		if line == 0 {
+			line = findLine(lines, msgid)
+		}
+		if line == 0 || line > len(lines) {
+			continue
+		}
+		usages = append(usages, newSourceUsage(ref, relativeTo(projectRoot, filePath), lines, line, contextLines, "reference"))
+	}
+
+	if len(usages) > 0 {
+		return usages, nil
+	}
+
+	files, err := utils.ReadSourceFiles(projectRoot, extensions)
+	if err != nil {
+		return nil, err
+	}
+	literals := msgIDLiterals(msgid)
+	for _, file := range files {
+		if !containsLiteral(file.Content, literals) {
+			continue
+		}
+		lines := strings.Split(string(file.Content), "\n")
+		for i, text := range lines {
+			if containsLiteral([]byte(text), literals) {
+				usages = append(usages, newSourceUsage("", file.Path, lines, i+1, contextLines, "search"))
+				if len(usages) >= maxSearchUsages {
+					return usages, nil
+				}
+			}
+		}
+	}
+
+	return usages, nil
+}
+
+func newSourceUsage(ref, file string, lines []string, line, contextLines int, source string) SourceUsage {
+	start := max(line-contextLines, 1)
+	end := min(line+contextLines, len(lines))
+
+	var b strings.Builder
+	for i := start; i <= end; i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&b, "%s%5d | %s\n", marker, i, lines[i-1])
+	}
+
+	return SourceUsage{
+		Reference: ref,
+		File:      file,
+		Line:      line,
+		StartLine: start,
+		EndLine:   end,
+		Snippet:   b.String(),
+		Source:    source,
+	}
+}
+
+// findLine returns the 1-based line containing the msgid as a string literal, or 0
+func findLine(lines []string, msgid string) int {
+	literals := msgIDLiterals(msgid)
+	for i, text := range lines {
+		if containsLiteral([]byte(text), literals) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func relativeTo(root, path string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
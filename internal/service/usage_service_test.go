@@ -0,0 +1,130 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckKeyUsage(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: de\n"
+
+#: src/a.js:1
+msgid "Line one\nLine two"
+msgstr ""
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Öffnen"
+
+msgid "Unused"
+msgstr "Unbenutzt"
+
+#~ msgid "Already obsolete"
+#~ msgstr "Schon veraltet"
+`))
+	require.NoError(t, err)
+
+	files := []utils.SourceFile{
+		{Path: "src/a.js", Content: []byte(`const text = "Line one\nLine two";`)},
+		{Path: "src/b.js", Content: []byte(`pgettext('menu', 'Open'); pgettext('toolbar', 'Open');`)},
+	}
+
+	report := CheckKeyUsage(catalog, "", files, utils.DefaultExtractOptions())
+
+	require.Len(t, report.Orphaned, 1)
+	assert.Equal(t, "Unused", report.Orphaned[0].MsgID)
+
+	require.Len(t, report.Missing, 1)
+	assert.Equal(t, "toolbar", report.Missing[0].Context)
+	assert.Equal(t, []string{"src/b.js:1"}, report.Missing[0].References)
+}
+
+func TestCheckKeyUsageReferences(t *testing.T) {
+	projectDir := t.TempDir()
+	sourceDir := filepath.Join(projectDir, "web")
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, "server"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "server", "views.py"), []byte("_(\"Python only\")\n"), 0644))
+
+	catalog, err := utils.ParseCatalog([]byte(`#: server/views.py:1
+msgid "Python only"
+msgstr "Nur Python"
+
+#: web/app.js:1
+msgid "Moved away"
+msgstr "Verschoben"
+
+#: server/deleted.py:3
+msgid "Deleted file"
+msgstr "Gelöschte Datei"
+`))
+	require.NoError(t, err)
+
+	files := []utils.SourceFile{
+		{Path: "app.js", Content: []byte(`render();`)},
+	}
+	report := CheckKeyUsage(catalog, sourceDir, files, utils.DefaultExtractOptions(), projectDir)
+
+	// Files that were not scanned keep their entries, references to scanned files
+	// without the msgid or to missing files do not
+	var orphaned []string
+	for _, entry := range report.Orphaned {
+		orphaned = append(orphaned, entry.MsgID)
+	}
+	assert.Equal(t, []string{"Moved away", "Deleted file"}, orphaned)
+}
+
+func TestFoundInFiles(t *testing.T) {
+	files := []utils.SourceFile{
+		{Path: "src/a.js", Content: []byte(`saveFile(); t("Save changes"); t('It\'s done'); t(` + "`" + `First
+Second` + "`" + `);`)},
+		{Path: "src/b.js", Content: []byte(`const label = "Say \"hi\"";`)},
+	}
+
+	tests := []struct {
+		msgid string
+		found bool
+	}{
+		{"Save changes", true},
+		{"It's done", true},
+		{"First\nSecond", true},
+		{`Say "hi"`, true},
+		// Substrings of identifiers and longer strings are not usages
+		{"save", false},
+		{"Save", false},
+		{"done", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.found, foundInFiles(tt.msgid, files), tt.msgid)
+	}
+}
+
+func TestMarkObsolete(t *testing.T) {
+	catalog, err := utils.ParseCatalog([]byte(`msgid "First"
+msgstr "Erste"
+
+#: src/old.js:1
+msgid "Second"
+msgstr "Zweite"
+
+msgid "Third"
+msgstr "Dritte"
+`))
+	require.NoError(t, err)
+
+	count := MarkObsolete(catalog, []OrphanedEntry{{MsgID: "First"}, {MsgID: "Missing"}})
+	assert.Equal(t, 1, count)
+
+	require.Len(t, catalog.Entries, 3)
+	assert.Equal(t, "Second", catalog.Entries[0].ID)
+	assert.Equal(t, "First", catalog.Entries[2].ID)
+	assert.True(t, catalog.Entries[2].Obsolete)
+	assert.Nil(t, catalog.Lookup("", "First"))
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// ValidationIssue describes a problem found in the translation of one entry
+type ValidationIssue struct {
+	Context  string `json:"context,omitempty"`
+	MsgID    string `json:"msgid"`
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+var (
+	tagPattern          = regexp.MustCompile(`<(/?)([a-zA-Z][\w:.-]*)((?:\s+[^<>]*?)?)\s*(/?)>`)
+	attributePattern    = regexp.MustCompile(`([\w:.-]+)(?:\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+))?`)
+	entityPattern       = regexp.MustCompile(`&(?:[a-zA-Z][a-zA-Z0-9]*|#[0-9]+|#[xX][0-9a-fA-F]+);`)
+	markdownLinkPattern = regexp.MustCompile(`\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+)
+
+// voidElements never have a closing tag
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// translatableAttributes may legitimately differ between msgid and msgstr
+var translatableAttributes = map[string]bool{
+	"title": true, "alt": true, "aria-label": true, "placeholder": true, "label": true, "summary": true,
+}
+
+type markupTag struct {
+	name      string
+	closing   bool
+	selfClose bool
+	attrs     map[string]string
+}
+
+// ValidateTranslation checks that the markup of a translation matches its source:
+// HTML tags and their nesting, non-translatable attribute values, entities and Markdown link targets
+func ValidateTranslation(context, msgid, msgstr string) []ValidationIssue {
+	issues := []ValidationIssue{}
+	if msgstr == "" {
+		return issues
+	}
+	add := func(check, severity, format string, args ...any) {
+		issues = append(issues, ValidationIssue{
+			Context:  context,
+			MsgID:    msgid,
+			Check:    check,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	sourceTags := parseTags(msgid)
+	targetTags := parseTags(msgstr)
+
+	if missing, extra := diffMultiset(tagSignatures(sourceTags), tagSignatures(targetTags)); len(missing) > 0 || len(extra) > 0 {
+		add("html_tags", SeverityError, "tags differ from source%s", describeDiff(missing, extra))
+	} else if tagsNested(sourceTags) && !tagsNested(targetTags) {
+		add("tag_nesting", SeverityError, "tags are not properly nested")
+	}
+
+	for _, problem := range compareAttributes(sourceTags, targetTags) {
+		add("tag_attributes", SeverityError, "%s", problem)
+	}
+
+	if missing, extra := diffMultiset(entityPattern.FindAllString(msgid, -1), entityPattern.FindAllString(msgstr, -1)); len(missing) > 0 || len(extra) > 0 {
+		add("html_entities", SeverityWarning, "entities differ from source%s", describeDiff(missing, extra))
+	}
+
+	if missing, extra := diffMultiset(markdownLinkTargets(msgid), markdownLinkTargets(msgstr)); len(missing) > 0 || len(extra) > 0 {
+		add("markdown_links", SeverityError, "Markdown link targets differ from source%s", describeDiff(missing, extra))
+	}
+
+	return issues
+}
+
+// ValidateEntry validates every msgstr form of an entry against its msgid or msgid_plural
+func ValidateEntry(entry *utils.CatalogEntry) []ValidationIssue {
+	issues := []ValidationIssue{}
+	for i, msgstr := range entry.Str {
+		source := entry.ID
+		if i > 0 && entry.PluralID != "" {
+			source = entry.PluralID
+		}
+		for _, issue := range ValidateTranslation(entry.Context, source, msgstr) {
+			issue.MsgID = entry.ID
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// CheckCharset reports characters of a translation that the charset of its catalog
+// cannot represent. Such translations cannot be saved, even when forced.
+func CheckCharset(context, msgid, msgstr, charset string) []ValidationIssue {
+	issues := []ValidationIssue{}
+	if missing := utils.UnrepresentableRunes(msgstr, charset); len(missing) > 0 {
+		issues = append(issues, ValidationIssue{
+			Context:  context,
+			MsgID:    msgid,
+			Check:    "charset",
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("characters %q cannot be represented in the file charset %s, convert the file to UTF-8 first", string(missing), charset),
+		})
+	}
+	return issues
+}
+
+// HasErrors reports whether any issue has error severity
+func HasErrors(issues []ValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTags(s string) []markupTag {
+	var tags []markupTag
+	for _, m := range tagPattern.FindAllStringSubmatch(s, -1) {
+		tag := markupTag{
+			name:      strings.ToLower(m[2]),
+			closing:   m[1] == "/",
+			selfClose: m[4] == "/",
+			attrs:     make(map[string]string),
+		}
+		for _, a := range attributePattern.FindAllStringSubmatch(m[3], -1) {
+			tag.attrs[strings.ToLower(a[1])] = strings.Trim(a[2], `"'`)
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func tagSignatures(tags []markupTag) []string {
+	signatures := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		switch {
+		case tag.closing:
+			signatures = append(signatures, "</"+tag.name+">")
+		case tag.selfClose:
+			signatures = append(signatures, "<"+tag.name+"/>")
+		default:
+			signatures = append(signatures, "<"+tag.name+">")
+		}
+	}
+	return signatures
+}
+
+// tagsNested reports whether opening and closing tags are balanced and properly nested
+func tagsNested(tags []markupTag) bool {
+	var stack []string
+	for _, tag := range tags {
+		if tag.selfClose || voidElements[tag.name] {
+			continue
+		}
+		if !tag.closing {
+			stack = append(stack, tag.name)
+			continue
+		}
+		if len(stack) == 0 || stack[len(stack)-1] != tag.name {
+			return false
+		}
+		stack = stack[:len(stack)-1]
+	}
+	return len(stack) == 0
+}
+
+// compareAttributes pairs the n-th opening tag of each name in source and target and
+// compares their attribute names and non-translatable attribute values
+func compareAttributes(source, target []markupTag) []string {
+	byName := func(tags []markupTag) map[string][]markupTag {
+		m := make(map[string][]markupTag)
+		for _, tag := range tags {
+			if !tag.closing {
+				m[tag.name] = append(m[tag.name], tag)
+			}
+		}
+		return m
+	}
+	sourceByName, targetByName := byName(source), byName(target)
+
+	names := make([]string, 0, len(sourceByName))
+	for name := range sourceByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []string
+	for _, name := range names {
+		targets := targetByName[name]
+		for i, src := range sourceByName[name] {
+			if i >= len(targets) {
+				break
+			}
+			tgt := targets[i]
+			for _, attr := range sortedKeys(src.attrs) {
+				value, ok := tgt.attrs[attr]
+				switch {
+				case !ok:
+					problems = append(problems, fmt.Sprintf("attribute %q of <%s> is missing", attr, name))
+				case !translatableAttributes[attr] && value != src.attrs[attr]:
+					problems = append(problems, fmt.Sprintf("attribute %q of <%s> changed from %q to %q", attr, name, src.attrs[attr], value))
+				}
+			}
+			for _, attr := range sortedKeys(tgt.attrs) {
+				if _, ok := src.attrs[attr]; !ok {
+					problems = append(problems, fmt.Sprintf("attribute %q of <%s> is not in the source", attr, name))
+				}
+			}
+		}
+	}
+	return problems
+}
+
+func markdownLinkTargets(s string) []string {
+	var targets []string
+	for _, m := range markdownLinkPattern.FindAllStringSubmatch(s, -1) {
+		targets = append(targets, m[1])
+	}
+	return targets
+}
+
+// diffMultiset returns the items of source missing from target and the extra items of target
+func diffMultiset(source, target []string) (missing, extra []string) {
+	counts := make(map[string]int)
+	for _, s := range source {
+		counts[s]++
+	}
+	for _, t := range target {
+		if counts[t] > 0 {
+			counts[t]--
+		} else {
+			extra = append(extra, t)
+		}
+	}
+	for _, s := range source {
+		if counts[s] > 0 {
+			missing = append(missing, s)
+			counts[s]--
+		}
+	}
+	return missing, extra
+}
+
+func describeDiff(missing, extra []string) string {
+	var parts []string
+	if len(missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		parts = append(parts, "unexpected "+strings.Join(extra, ", "))
+	}
+	return ": " + strings.Join(parts, "; ")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTranslation(t *testing.T) {
+	checksOf := func(issues []ValidationIssue) []string {
+		checks := []string{}
+		for _, issue := range issues {
+			checks = append(checks, issue.Check)
+		}
+		return checks
+	}
+
+	tests := []struct {
+		name   string
+		msgid  string
+		msgstr string
+		checks []string
+	}{
+		{"Matching tags", "Click <b>here</b>", "Cliquez <b>ici</b>", []string{}},
+		{"Reordered tags", "<b>Bold</b> and <i>italic</i>", "<i>Italique</i> et <b>gras</b>", []string{}},
+		{"Void and self-closing tags", "Line<br>break <img src=\"a.png\"/>", "Ligne<br>coupure <img src=\"a.png\"/>", []string{}},
+		{"Missing closing tag", "Click <b>here</b>", "Cliquez <b>ici", []string{"html_tags"}},
+		{"Broken nesting", "<b><i>x</i></b>", "<b><i>x</b></i>", []string{"tag_nesting"}},
+		{"Changed URL", `<a href="/docs">docs</a>`, `<a href="/doc">doc</a>`, []string{"tag_attributes"}},
+		{"Translated title", `<a href="/docs" title="Docs">docs</a>`, `<a href="/docs" title="Documentation">doc</a>`, []string{}},
+		{"Extra attribute", `<a href="/docs">docs</a>`, `<a href="/docs" target="_blank">doc</a>`, []string{"tag_attributes"}},
+		{"Entity dropped", "Tom &amp; Jerry", "Tom et Jerry", []string{"html_entities"}},
+		{"Markdown link target", "[Guide](https://a.io/g)", "[Guía](https://a.io/es/g)", []string{"markdown_links"}},
+		{"Markdown link text", "[Guide](https://a.io/g)", "[Guía](https://a.io/g)", []string{}},
+		{"Empty translation", "Click <b>here</b>", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.checks, checksOf(ValidateTranslation("", tt.msgid, tt.msgstr)))
+		})
+	}
+}
+
+func TestHasErrors(t *testing.T) {
+	assert.False(t, HasErrors(ValidateTranslation("", "Tom &amp; Jerry", "Tom et Jerry")))
+	assert.True(t, HasErrors(ValidateTranslation("", "<b>x</b>", "x")))
+}
+
+func TestCheckCharset(t *testing.T) {
+	assert.Empty(t, CheckCharset("", "Price", "Prix en €", "UTF-8"))
+	assert.Empty(t, CheckCharset("", "Delete", "Supprimer l'élément", "ISO-8859-1"))
+
+	issues := CheckCharset("", "Price", "Prix en €", "ISO-8859-1")
+	require.Len(t, issues, 1)
+	assert.Equal(t, "charset", issues[0].Check)
+	assert.True(t, HasErrors(issues))
+	assert.Contains(t, issues[0].Message, "€")
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewAddGlossaryTermTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("addGlossaryTerm",
+		mcp.WithDescription("Add a term to the project glossary, with its required translation in one language or marked as a name that must never be translated. An existing term with the same text and language is replaced."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file or directory inside the project; the glossary is stored in .i18n-mcp/glossary.json at the project root"),
+		),
+		mcp.WithString("term",
+			mcp.Required(),
+			mcp.Description("The source term, such as \"Workspace\""),
+		),
+		mcp.WithString("language",
+			mcp.Description("The language of the translation, such as \"de\". Required unless do_not_translate is set"),
+		),
+		mcp.WithString("translation",
+			mcp.Description("The required translation of the term"),
+		),
+		mcp.WithString("do_not_translate",
+			mcp.Description("Set to \"true\" for brand names that must be kept as is (default: false)"),
+		),
+		mcp.WithString("case_sensitive",
+			mcp.Description("Set to \"true\" to match the term case-sensitively (default: false)"),
+		),
+		mcp.WithString("note",
+			mcp.Description("A note for translators explaining the term"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the glossary file instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+
+		termStr, err := request.RequireString("term")
+		if err != nil {
+			return nil, fmt.Errorf("term parameter is required: %w", err)
+		}
+
+		doNotTranslate, err := strconv.ParseBool(request.GetString("do_not_translate", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid do_not_translate value: %v", err)), nil
+		}
+
+		caseSensitive, err := strconv.ParseBool(request.GetString("case_sensitive", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid case_sensitive value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		projectRoot := utils.FindProjectRoot(path)
+		glossary, err := service.LoadGlossary(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading glossary: %v", err)), nil
+		}
+
+		term := service.GlossaryTerm{
+			Term:           termStr,
+			Language:       request.GetString("language", ""),
+			Translation:    request.GetString("translation", ""),
+			DoNotTranslate: doNotTranslate,
+			CaseSensitive:  caseSensitive,
+			Note:           request.GetString("note", ""),
+		}
+		replaced, err := glossary.Add(term)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid glossary term: %v", err)), nil
+		}
+
+		diff := ""
+		if dryRun {
+			diff, err = glossary.Diff(projectRoot)
+		} else {
+			err = glossary.Save(projectRoot)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error writing glossary: %v", err)), nil
+		}
+
+		message := fmt.Sprintf("Added %q to the glossary", term.Term)
+		if replaced {
+			message = fmt.Sprintf("Replaced %q in the glossary", term.Term)
+		}
+
+		result := map[string]any{
+			"project_root": projectRoot,
+			"replaced":     replaced,
+			"term_count":   len(glossary.Terms),
+			"message":      message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,134 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddGlossaryTermTool(t *testing.T) {
+	// Create temporary project with a .git marker
+	tempDir, err := os.MkdirTemp("", "po_glossary_add_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+	poFile := filepath.Join(tempDir, "locales", "de.po")
+	require.NoError(t, os.MkdirAll(filepath.Dir(poFile), 0755))
+	require.NoError(t, os.WriteFile(poFile, []byte(""), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewAddGlossaryTermTool()
+
+	// Verify tool properties
+	assert.Equal(t, "addGlossaryTerm", tool.Name)
+	assert.Contains(t, tool.Description, "glossary")
+
+	t.Run("Add Terms", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":        poFile,
+			"term":        "Workspace",
+			"language":    "de",
+			"translation": "Arbeitsbereich",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		result, err = handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":             poFile,
+			"term":             "Acme",
+			"do_not_translate": "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, tempDir, resultData["project_root"])
+		assert.Equal(t, float64(2), resultData["term_count"])
+		assert.Equal(t, false, resultData["replaced"])
+
+		content, err := os.ReadFile(filepath.Join(tempDir, ".i18n-mcp", "glossary.json"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"translation": "Arbeitsbereich"`)
+		assert.Contains(t, string(content), `"do_not_translate": true`)
+	})
+
+	t.Run("Replace Term", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":        tempDir,
+			"term":        "Workspace",
+			"language":    "de",
+			"translation": "Workspace",
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, true, resultData["replaced"])
+		assert.Equal(t, float64(2), resultData["term_count"])
+	})
+
+	t.Run("Invalid Term", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path": poFile,
+			"term": "Seat",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid glossary term")
+	})
+
+	t.Run("Invalid Boolean", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":             poFile,
+			"term":             "Acme",
+			"do_not_translate": "yes please",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid do_not_translate value")
+	})
+
+	t.Run("Missing Parameters", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{"term": "Seat"}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "path parameter is required")
+
+		_, err = handler(context.Background(), makeRequest(map[string]interface{}{"path": poFile}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "term parameter is required")
+	})
+
+	t.Run("Dry Run", func(t *testing.T) {
+		glossaryPath := filepath.Join(tempDir, ".i18n-mcp", "glossary.json")
+		before, err := os.ReadFile(glossaryPath)
+		require.NoError(t, err)
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":        poFile,
+			"term":        "Dashboard",
+			"language":    "de",
+			"translation": "Übersicht",
+			"dry_run":     "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, true, resultData["dry_run"])
+		assert.Contains(t, resultData["diff"], `+      "translation": "Übersicht"`)
+
+		after, err := os.ReadFile(glossaryPath)
+		require.NoError(t, err)
+		assert.Equal(t, string(before), string(after))
+	})
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewAddLanguageTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("addLanguage",
+		mcp.WithDescription("Create the PO file of a new language from a .pot template or the PO file of another language. The file is placed following the layout of the existing catalogs (such as locale/<lang>/LC_MESSAGES/app.po or app.<lang>.po), its Language, Plural-Forms and charset headers are filled in and every msgstr is empty."),
+		mcp.WithString("template_path",
+			mcp.Required(),
+			mcp.Description("The .pot file, or the .po file of an existing language, to create the new catalog from"),
+		),
+		mcp.WithString("language",
+			mcp.Required(),
+			mcp.Description("BCP 47 tag of the new language, such as pt-BR or sr-Latn"),
+		),
+		mcp.WithString("directory",
+			mcp.Description("Directory whose catalogs show where the new file belongs (default: the project root of the template)"),
+		),
+		mcp.WithString("output_path",
+			mcp.Description("Create the file at this path instead of following the layout of the existing catalogs"),
+		),
+		mcp.WithString("force",
+			mcp.Description("Set to \"true\" to overwrite an existing file (default: false)"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the new file instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		templatePath, err := request.RequireString("template_path")
+		if err != nil {
+			return nil, fmt.Errorf("template_path parameter is required: %w", err)
+		}
+
+		language, err := request.RequireString("language")
+		if err != nil {
+			return nil, fmt.Errorf("language parameter is required: %w", err)
+		}
+		language = utils.NormalizeLanguageTag(language)
+
+		force, err := strconv.ParseBool(request.GetString("force", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid force value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		template, err := utils.ParseCatalogFile(templatePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing template file: %v", err)), nil
+		}
+
+		catalog, err := service.NewLanguageCatalog(template, language)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating catalog: %v", err)), nil
+		}
+
+		outputPath := request.GetString("output_path", "")
+		if outputPath == "" {
+			directory := request.GetString("directory", utils.FindProjectRoot(templatePath))
+			poFilesInfo, err := utils.ScanPoFilesWithInfo(directory)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+			}
+			outputPath = service.LanguageCatalogPath(templatePath, language, poFilesInfo)
+		}
+
+		if _, err := os.Stat(outputPath); err == nil && !force {
+			return mcp.NewToolResultError(fmt.Sprintf("File already exists: %s, set force to overwrite it", outputPath)), nil
+		}
+		if !dryRun {
+			if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error creating directory: %v", err)), nil
+			}
+		}
+
+		diff, err := saveCatalog(catalog, outputPath, dryRun)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+		}
+
+		entryCount := len(catalog.ActiveEntries())
+		message := fmt.Sprintf("Created %s for %s with %d untranslated entries", outputPath, language, entryCount)
+		if dryRun {
+			message = fmt.Sprintf("Dry run: %s would be created for %s with %d untranslated entries, nothing was written", outputPath, language, entryCount)
+		}
+
+		result := map[string]any{
+			"file_path":     outputPath,
+			"template_path": templatePath,
+			"language":      language,
+			"plural_forms":  catalog.HeaderValue("Plural-Forms"),
+			"entry_count":   entryCount,
+			"message":       message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddLanguageTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_add_language_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+
+	templatePath := filepath.Join(tempDir, "locale", "app.pot")
+	frFile := filepath.Join(tempDir, "locale", "fr", "LC_MESSAGES", "app.po")
+	require.NoError(t, os.MkdirAll(filepath.Dir(frFile), 0755))
+	require.NoError(t, os.WriteFile(templatePath, []byte("#, fuzzy\nmsgid \"\"\nmsgstr \"\"\n\"Language: \\n\"\n\"Content-Type: text/plain; charset=CHARSET\\n\"\n\n#: src/app.js:3\nmsgid \"Save\"\nmsgstr \"\"\n\nmsgid \"One file\"\nmsgid_plural \"%d files\"\nmsgstr[0] \"\"\nmsgstr[1] \"\"\n"), 0644))
+	require.NoError(t, os.WriteFile(frFile, []byte("msgid \"\"\nmsgstr \"\"\n\"Language: fr\\n\"\n\nmsgid \"Save\"\nmsgstr \"Enregistrer\"\n"), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewAddLanguageTool()
+
+	// Verify tool properties
+	assert.Equal(t, "addLanguage", tool.Name)
+	assert.Contains(t, tool.Description, "new language")
+
+	addLanguage := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	ruFile := filepath.Join(tempDir, "locale", "ru", "LC_MESSAGES", "app.po")
+
+	t.Run("Dry Run", func(t *testing.T) {
+		resultData := addLanguage(t, map[string]interface{}{
+			"template_path": templatePath,
+			"language":      "ru",
+			"dry_run":       "true",
+		})
+		assert.Equal(t, ruFile, resultData["file_path"])
+		assert.Contains(t, resultData["diff"], "--- /dev/null\n")
+		assert.Contains(t, resultData["diff"], "+msgstr[2] \"\"\n")
+		assert.NoFileExists(t, ruFile)
+	})
+
+	t.Run("Create From Template", func(t *testing.T) {
+		resultData := addLanguage(t, map[string]interface{}{
+			"template_path": templatePath,
+			"language":      "ru",
+		})
+		assert.Equal(t, float64(2), resultData["entry_count"])
+
+		content, err := os.ReadFile(ruFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\"Language: ru\\n\"")
+		assert.Contains(t, string(content), "\"Plural-Forms: nplurals=3;")
+		assert.Contains(t, string(content), "charset=UTF-8")
+		assert.NotContains(t, string(content), "#, fuzzy")
+	})
+
+	t.Run("Existing File", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"template_path": templatePath,
+			"language":      "ru",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "File already exists")
+	})
+
+	t.Run("Create From Other Language", func(t *testing.T) {
+		resultData := addLanguage(t, map[string]interface{}{
+			"template_path": frFile,
+			"language":      "pt_br",
+		})
+		ptFile := filepath.Join(tempDir, "locale", "pt_BR", "LC_MESSAGES", "app.po")
+		assert.Equal(t, ptFile, resultData["file_path"])
+		assert.Equal(t, "pt-BR", resultData["language"])
+
+		content, err := os.ReadFile(ptFile)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "Enregistrer")
+	})
+
+	t.Run("Unknown Language", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"template_path": templatePath,
+			"language":      "xx",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "no plural rules known")
+	})
+}
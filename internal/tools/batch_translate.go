@@ -0,0 +1,261 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// batchFileResult is the outcome of a batch translation for one catalog
+type batchFileResult struct {
+	Target   string `json:"target"`
+	FilePath string `json:"file_path"`
+	Language string `json:"language"`
+	service.TranslationOutcome
+	Written bool   `json:"written"`
+	Diff    string `json:"diff,omitempty"`
+	Error   string `json:"error,omitempty"`
+
+	catalog *utils.Catalog
+}
+
+func NewBatchTranslateTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("batchTranslate",
+		mcp.WithDescription("Translate terms in many PO files in one call, such as one new string in every language. Every translation is validated like in the translate tool before any file is written, then all changed files are saved. Targets are .po file paths or languages resolved to the catalog of that language in directory. Returns the result for each file and the change made to each key: new, changed, unchanged, unknown (added to the catalog) or rejected."),
+		mcp.WithString("translations",
+			mcp.Required(),
+			mcp.Description("JSON object mapping a .po file path or a language to an object of msgid to translation, e.g. {\"de\": {\"Save\": \"Speichern\"}, \"locale/fr.po\": {\"Save\": \"Enregistrer\"}}"),
+		),
+		mcp.WithString("directory",
+			mcp.Description("Directory scanned for the catalogs of language targets; relative file paths are resolved against it"),
+		),
+		mcp.WithString("force",
+			mcp.Description("Set to \"true\" to save translations even when validation reports errors (default: false). Characters the file charset cannot represent are always rejected"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to validate the translations and return a unified diff of each file instead of writing them (default: false)"),
+		),
+		mcp.WithString("review_state",
+			mcp.Description("Review state set on the changed translations: draft, reviewed, approved or none to leave it unset (default: draft). Draft translations are listed by listPendingReviews"),
+		),
+		mcp.WithString("source",
+			mcp.Description("Where the translations come from, recorded as a provenance comment on the changed entries: ai, human, tm or import (default: ai)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		translationsStr, err := request.RequireString("translations")
+		if err != nil {
+			return nil, fmt.Errorf("translations parameter is required: %w", err)
+		}
+
+		var batch map[string]map[string]string
+		if err := json.Unmarshal([]byte(translationsStr), &batch); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid translations JSON: %v", err)), nil
+		}
+
+		force, err := strconv.ParseBool(request.GetString("force", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid force value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		reviewState, err := reviewStateFromRequest(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		provenance, err := provenanceFromRequest(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		directory := request.GetString("directory", "")
+
+		targets := make([]string, 0, len(batch))
+		for target := range batch {
+			targets = append(targets, target)
+		}
+		sort.Strings(targets)
+
+		// Resolve every target to its catalog before changing anything
+		var catalogFiles []service.CatalogFile
+		results := []*batchFileResult{}
+		byPath := make(map[string]string)
+		problems := []string{}
+		for _, target := range targets {
+			result := &batchFileResult{Target: target}
+			if isPoPath(target) {
+				result.FilePath = target
+				if directory != "" && !filepath.IsAbs(target) {
+					result.FilePath = filepath.Join(directory, target)
+				}
+				catalog, err := utils.ParseCatalogFile(result.FilePath)
+				if err != nil {
+					problems = append(problems, fmt.Sprintf("%s: error parsing PO file: %v", target, err))
+					continue
+				}
+				result.catalog = catalog
+				result.Language = utils.NewPoFileInfo(result.FilePath, catalog.Language()).Language
+			} else {
+				if directory == "" {
+					problems = append(problems, fmt.Sprintf("%s: directory is required to find the catalog of a language", target))
+					continue
+				}
+				if catalogFiles == nil {
+					catalogFiles, _, err = service.LoadCatalogFiles(directory)
+					if err != nil {
+						return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+					}
+				}
+				file, err := catalogForLanguage(catalogFiles, target)
+				if err != nil {
+					problems = append(problems, fmt.Sprintf("%s: %v", target, err))
+					continue
+				}
+				result.FilePath, result.Language, result.catalog = file.Path, file.Language, file.Catalog
+			}
+
+			abs, _ := filepath.Abs(result.FilePath)
+			if other, ok := byPath[abs]; ok {
+				problems = append(problems, fmt.Sprintf("%s: %s is also targeted by %s", target, result.FilePath, other))
+				continue
+			}
+			byPath[abs] = target
+			results = append(results, result)
+		}
+		if len(problems) > 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("No files were changed:\n%s", strings.Join(problems, "\n"))), nil
+		}
+
+		// Validate and apply all translations in memory
+		type projectConfig struct {
+			constraints *service.Constraints
+			glossary    *service.Glossary
+		}
+		configs := make(map[string]projectConfig)
+		for _, result := range results {
+			projectRoot := utils.FindProjectRoot(result.FilePath)
+			config, ok := configs[projectRoot]
+			if !ok {
+				if config.constraints, err = service.LoadConstraints(projectRoot); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error loading constraints: %v", err)), nil
+				}
+				if config.glossary, err = service.LoadGlossary(projectRoot); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error loading glossary: %v", err)), nil
+				}
+				configs[projectRoot] = config
+			}
+
+			result.TranslationOutcome = service.ApplyTranslations(result.catalog, batch[result.Target], config.constraints, config.glossary, force)
+			if err := service.MarkApplied(result.catalog, result.Applied, reviewState); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error setting review state: %v", err)), nil
+			}
+			if err := service.MarkProvenance(result.catalog, result.Applied, provenance); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error recording provenance: %v", err)), nil
+			}
+		}
+
+		// Write every file with accepted translations
+		translatedCount, rejectedCount, writeErrors := 0, 0, 0
+		for _, result := range results {
+			translatedCount += result.TranslatedCount
+			rejectedCount += len(result.Rejected)
+			if result.TranslatedCount == 0 {
+				continue
+			}
+			diff, err := saveCatalog(result.catalog, result.FilePath, dryRun)
+			if err != nil {
+				result.Error = fmt.Sprintf("Error writing to PO file: %v", err)
+				writeErrors++
+				continue
+			}
+			result.Diff = diff
+			result.Written = !dryRun
+			if result.Written {
+				if err := recordChanges(ctx, tool.Name, result.FilePath, result.catalog, result.Applied); err != nil {
+					result.Error = fmt.Sprintf("Error recording changes in the journal: %v", err)
+				}
+			}
+		}
+
+		message := fmt.Sprintf("Successfully translated %d terms in %d files", translatedCount, len(results))
+		if dryRun {
+			message = fmt.Sprintf("Dry run: %d terms would be translated in %d files, nothing was written", translatedCount, len(results))
+		}
+		if rejectedCount > 0 {
+			message += fmt.Sprintf(". Rejected %d terms with validation errors, fix them or retry with force", rejectedCount)
+		}
+		if writeErrors > 0 {
+			message += fmt.Sprintf(". %d files could not be written", writeErrors)
+		}
+
+		result := map[string]any{
+			"translated_count": translatedCount,
+			"rejected_count":   rejectedCount,
+			"file_count":       len(results),
+			"files":            results,
+			"message":          message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+// isPoPath reports whether a batch target is a file path rather than a language
+func isPoPath(target string) bool {
+	ext := strings.ToLower(filepath.Ext(target))
+	return ext == ".po" || ext == ".pot" || strings.ContainsAny(target, `/\`)
+}
+
+// catalogForLanguage returns the only catalog of a language. An exact language match
+// wins, so "pt" picks pt.po over pt_BR.po; otherwise "pt" selects a single pt-BR catalog.
+func catalogForLanguage(files []service.CatalogFile, language string) (service.CatalogFile, error) {
+	normalized := utils.NormalizeLanguageTag(language)
+	var exact, matching []service.CatalogFile
+	for _, file := range files {
+		if file.Language == normalized {
+			exact = append(exact, file)
+		} else if utils.LanguagesMatch(normalized, file.Language) {
+			matching = append(matching, file)
+		}
+	}
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = matching
+	}
+
+	switch len(candidates) {
+	case 0:
+		return service.CatalogFile{}, fmt.Errorf("no catalog found for language %q", language)
+	case 1:
+		return candidates[0], nil
+	}
+	paths := make([]string, len(candidates))
+	for i, file := range candidates {
+		paths[i] = file.Path
+	}
+	return service.CatalogFile{}, fmt.Errorf("several catalogs found for language %q, use one of the file paths instead: %s", language, strings.Join(paths, ", "))
+}
@@ -0,0 +1,186 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchTranslateTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_batch_translate_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	writeFiles := func(t *testing.T) {
+		files := map[string]string{
+			"de.po":    "msgid \"Save\"\nmsgstr \"\"\n\nmsgid \"Click <b>here</b>\"\nmsgstr \"\"\n",
+			"fr.po":    "msgid \"Save\"\nmsgstr \"\"\n",
+			"pt_BR.po": "msgid \"\"\nmsgstr \"\"\n\"Language: pt_BR\\n\"\n\nmsgid \"Save\"\nmsgstr \"\"\n",
+		}
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+		}
+	}
+	readFile := func(t *testing.T, name string) string {
+		content, err := os.ReadFile(filepath.Join(tempDir, name))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	// Get the tool and handler
+	tool, handler := NewBatchTranslateTool()
+
+	// Verify tool properties
+	assert.Equal(t, "batchTranslate", tool.Name)
+	assert.Contains(t, tool.Description, "many PO files")
+
+	t.Run("Languages And Paths", func(t *testing.T) {
+		writeFiles(t)
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":    tempDir,
+			"translations": `{"de": {"Save": "Speichern", "Click <b>here</b>": "Hier <b>klicken</b>"}, "fr.po": {"Save": "Enregistrer"}, "pt": {"Save": "Salvar"}}`,
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(4), resultData["translated_count"])
+		assert.Equal(t, float64(3), resultData["file_count"])
+		files := resultData["files"].([]interface{})
+		require.Len(t, files, 3)
+		de := files[0].(map[string]interface{})
+		assert.Equal(t, "de", de["target"])
+		assert.Equal(t, filepath.Join(tempDir, "de.po"), de["file_path"])
+		assert.Equal(t, true, de["written"])
+		assert.Equal(t, map[string]interface{}{"Save": "new", "Click <b>here</b>": "new"}, de["changes"])
+		pt := files[2].(map[string]interface{})
+		assert.Equal(t, "pt-BR", pt["language"])
+
+		assert.Contains(t, readFile(t, "de.po"), "msgstr \"Speichern\"")
+		assert.Contains(t, readFile(t, "de.po"), "msgstr \"Hier <b>klicken</b>\"")
+		assert.Contains(t, readFile(t, "fr.po"), "msgstr \"Enregistrer\"")
+		assert.Contains(t, readFile(t, "pt_BR.po"), "msgstr \"Salvar\"")
+	})
+
+	t.Run("Clear Fuzzy", func(t *testing.T) {
+		writeFiles(t)
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "fr.po"), []byte("#, fuzzy\n#| msgid \"Save file\"\nmsgid \"Save\"\nmsgstr \"Enregistrer le fichier\"\n"), 0644))
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":    tempDir,
+			"translations": `{"fr": {"Save": "Enregistrer"}}`,
+			"review_state": "none",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+		assert.Contains(t, getTextContent(t, result), `"Save": "new"`)
+
+		content := readFile(t, "fr.po")
+		assert.Contains(t, content, "msgid \"Save\"\nmsgstr \"Enregistrer\"\n")
+		assert.NotContains(t, content, "fuzzy")
+		assert.NotContains(t, content, "#|")
+	})
+
+	t.Run("Rejected Keys", func(t *testing.T) {
+		writeFiles(t)
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":    tempDir,
+			"translations": `{"de": {"Save": "Speichern", "Click <b>here</b>": "Hier <b>klicken"}, "fr": {"Save": "Enregistrer"}}`,
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(2), resultData["translated_count"])
+		assert.Equal(t, float64(1), resultData["rejected_count"])
+		de := resultData["files"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"Save": "new", "Click <b>here</b>": "rejected"}, de["changes"])
+		assert.Equal(t, []interface{}{"Click <b>here</b>"}, de["rejected"])
+		assert.NotEmpty(t, de["issues"])
+
+		assert.Contains(t, readFile(t, "de.po"), "msgstr \"Speichern\"")
+		assert.NotContains(t, readFile(t, "de.po"), "klicken")
+	})
+
+	t.Run("Dry Run", func(t *testing.T) {
+		writeFiles(t)
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":    tempDir,
+			"translations": `{"de": {"Save": "Speichern"}, "fr": {"Save": "Enregistrer"}}`,
+			"dry_run":      "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, true, resultData["dry_run"])
+		for _, file := range resultData["files"].([]interface{}) {
+			file := file.(map[string]interface{})
+			assert.Equal(t, false, file["written"])
+			assert.Contains(t, file["diff"], "+msgstr \"")
+		}
+		assert.NotContains(t, readFile(t, "de.po"), "Speichern")
+		assert.NotContains(t, readFile(t, "fr.po"), "Enregistrer")
+	})
+
+	t.Run("Unresolved Target Changes Nothing", func(t *testing.T) {
+		writeFiles(t)
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":    tempDir,
+			"translations": `{"de": {"Save": "Speichern"}, "ja": {"Save": "保存"}}`,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "No files were changed")
+		assert.Contains(t, getTextContent(t, result), "no catalog found for language \"ja\"")
+		assert.NotContains(t, readFile(t, "de.po"), "Speichern")
+	})
+
+	t.Run("Same File Targeted Twice", func(t *testing.T) {
+		writeFiles(t)
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":    tempDir,
+			"translations": `{"de": {"Save": "Speichern"}, "de.po": {"Save": "Sichern"}}`,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "is also targeted by")
+	})
+
+	t.Run("Language Without Directory", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"translations": `{"de": {"Save": "Speichern"}}`,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "directory is required")
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"translations": `{"de": ["Speichern"]}`,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid translations JSON")
+	})
+
+	t.Run("Missing Translations", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		assert.Error(t, err)
+	})
+}
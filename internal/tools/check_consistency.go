@@ -0,0 +1,139 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewCheckConsistencyTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("checkConsistency",
+		mcp.WithDescription("Check that the .po files of the same language in a directory translate consistently. Reports msgids translated differently across files and distinct msgids sharing an identical translation. Can propagate a chosen canonical translation to every file of a language."),
+		mcp.WithString("directory",
+			mcp.Required(),
+			mcp.Description("The directory path to scan for .po files"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Only check the files of this language. Required when propagating a translation"),
+		),
+		mcp.WithString("msgid",
+			mcp.Description("The msgid whose canonical translation should be propagated"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The msgctxt of the msgid to propagate"),
+		),
+		mcp.WithString("translation",
+			mcp.Description("The canonical translation to write into every file of the language containing msgid"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the propagation instead of writing the files (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		directory, err := request.RequireString("directory")
+		if err != nil {
+			return nil, fmt.Errorf("directory parameter is required: %w", err)
+		}
+		language := request.GetString("language", "")
+		msgid := request.GetString("msgid", "")
+		msgctxt := request.GetString("context", "")
+		translation := request.GetString("translation", "")
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		propagate := translation != ""
+		if propagate && (msgid == "" || language == "") {
+			return mcp.NewToolResultError("msgid and language are required to propagate a translation"), nil
+		}
+
+		catalogFiles, skipped, err := service.LoadCatalogFiles(directory)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+		}
+
+		// Group the files by language
+		byLanguage := make(map[string][]service.CatalogFile)
+		var matching []service.CatalogFile
+		for _, file := range catalogFiles {
+			if language != "" && !utils.LanguagesMatch(language, file.Language) {
+				continue
+			}
+			byLanguage[file.Language] = append(byLanguage[file.Language], file)
+			matching = append(matching, file)
+		}
+
+		propagated := []string{}
+		var diffs []string
+		if propagate {
+			files := matching
+			propagated = service.PropagateTranslation(files, msgctxt, msgid, translation)
+			changed := make(map[string]bool)
+			for _, path := range propagated {
+				changed[path] = true
+			}
+			for _, file := range files {
+				if !changed[file.Path] {
+					continue
+				}
+				diff, err := saveCatalog(file.Catalog, file.Path, dryRun)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+				}
+				diffs = append(diffs, diff)
+			}
+		}
+
+		languages := make([]string, 0, len(byLanguage))
+		for lang := range byLanguage {
+			languages = append(languages, lang)
+		}
+		sort.Strings(languages)
+
+		reports := []service.ConsistencyReport{}
+		divergentCount, sharedCount := 0, 0
+		for _, lang := range languages {
+			report := service.CheckConsistency(lang, byLanguage[lang])
+			divergentCount += len(report.Divergent)
+			sharedCount += len(report.SharedTranslations)
+			reports = append(reports, report)
+		}
+
+		result := map[string]any{
+			"directory":       directory,
+			"divergent_count": divergentCount,
+			"shared_count":    sharedCount,
+			"languages":       reports,
+			"skipped_files":   skipped,
+		}
+		if propagate {
+			result["propagated_files"] = propagated
+			result["message"] = fmt.Sprintf("Propagated the translation of %q to %d files", msgid, len(propagated))
+			if dryRun {
+				result["dry_run"] = true
+				result["diff"] = strings.Join(diffs, "")
+				result["message"] = fmt.Sprintf("Dry run: the translation of %q would be propagated to %d files, nothing was written", msgid, len(propagated))
+			}
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
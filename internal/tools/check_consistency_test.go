@@ -0,0 +1,162 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConsistencyTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_consistency_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"de/admin.po": `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgid "Save"
+msgstr "Speichern"
+
+msgid "Delete"
+msgstr "Löschen"
+`,
+		"de/web.po": `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgid "Save"
+msgstr "Sichern"
+
+msgid "Remove"
+msgstr "Löschen"
+`,
+		"de/email.po": `msgid ""
+msgstr ""
+"Language: de\n"
+
+# Subject line
+msgid "Save"
+msgstr "Abspeichern"
+`,
+		"fr/web.po": `msgid ""
+msgstr ""
+"Language: fr\n"
+
+msgid "Save"
+msgstr "Enregistrer"
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	// Get the tool and handler
+	tool, handler := NewCheckConsistencyTool()
+
+	// Verify tool properties
+	assert.Equal(t, "checkConsistency", tool.Name)
+	assert.Contains(t, tool.Description, "consistently")
+
+	t.Run("Report Inconsistencies", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(1), resultData["divergent_count"])
+		assert.Equal(t, float64(1), resultData["shared_count"])
+		languages := resultData["languages"].([]interface{})
+		require.Len(t, languages, 2)
+		de := languages[0].(map[string]interface{})
+		assert.Equal(t, "de", de["language"])
+		divergent := de["divergent"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "Save", divergent["msgid"])
+		assert.Len(t, divergent["variants"], 3)
+	})
+
+	t.Run("Unnormalized Language Filter", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+			"language":  "fr_FR",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		languages := resultData["languages"].([]interface{})
+		require.Len(t, languages, 1)
+		assert.Equal(t, "fr", languages[0].(map[string]interface{})["language"])
+	})
+
+	t.Run("Propagate Canonical Translation", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":   tempDir,
+			"language":    "DE",
+			"msgid":       "Save",
+			"translation": "Speichern",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Len(t, resultData["propagated_files"], 2)
+		assert.Equal(t, float64(0), resultData["divergent_count"])
+		assert.Len(t, resultData["languages"], 1)
+
+		content, err := os.ReadFile(filepath.Join(tempDir, "de", "email.po"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "# Subject line\nmsgid \"Save\"\nmsgstr \"Speichern\"")
+
+		// Other languages are untouched
+		content, err = os.ReadFile(filepath.Join(tempDir, "fr", "web.po"))
+		require.NoError(t, err)
+		assert.Equal(t, files["fr/web.po"], string(content))
+	})
+
+	t.Run("Propagate Without Language", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":   tempDir,
+			"msgid":       "Save",
+			"translation": "Speichern",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "msgid and language are required")
+	})
+
+	t.Run("Non-existent Directory", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": "/non/existent/directory",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error scanning for PO files")
+	})
+
+	t.Run("Missing Directory Parameter", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "directory parameter is required")
+	})
+}
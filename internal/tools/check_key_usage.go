@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewCheckKeyUsageTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("checkKeyUsage",
+		mcp.WithDescription("Cross-check a .po/.pot file against the source tree. Reports orphaned catalog entries that no source file uses anymore and source strings missing from the catalog. Optionally marks the orphaned entries obsolete."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po or .pot file"),
+		),
+		mcp.WithString("source_dir",
+			mcp.Required(),
+			mcp.Description("The source directory the catalog was extracted from"),
+		),
+		mcp.WithString("mark_obsolete",
+			mcp.Description("Set to \"true\" to mark orphaned entries obsolete (#~) and save the file (default: false)"),
+		),
+		mcp.WithString("keywords",
+			mcp.Description("Comma-separated translation functions in xgettext notation (default: same as extractStrings)"),
+		),
+		mcp.WithString("components",
+			mcp.Description("Comma-separated JSX component names whose content is translatable (default: Trans)"),
+		),
+		mcp.WithString("extensions",
+			mcp.Description("Comma-separated file extensions to scan (default: .js,.jsx,.ts,.tsx,.mjs,.cjs)"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the file when marking entries obsolete instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		sourceDir, err := request.RequireString("source_dir")
+		if err != nil {
+			return nil, fmt.Errorf("source_dir parameter is required: %w", err)
+		}
+
+		markObsolete, err := strconv.ParseBool(request.GetString("mark_obsolete", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid mark_obsolete value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		opts, err := extractOptionsFromRequest(request)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid keywords value: %v", err)), nil
+		}
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		files, err := utils.ReadSourceFiles(sourceDir, opts.Extensions)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error reading source files: %v", err)), nil
+		}
+
+		report := service.CheckKeyUsage(catalog, sourceDir, files, opts, utils.FindProjectRoot(filePath))
+
+		markedCount := 0
+		diff := ""
+		if markObsolete && len(report.Orphaned) > 0 {
+			markedCount = service.MarkObsolete(catalog, report.Orphaned)
+
+			diff, err = saveCatalog(catalog, filePath, dryRun)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+			}
+		}
+
+		result := map[string]any{
+			"file_path":             filePath,
+			"source_dir":            sourceDir,
+			"orphaned_count":        len(report.Orphaned),
+			"missing_count":         len(report.Missing),
+			"orphaned":              report.Orphaned,
+			"missing":               report.Missing,
+			"marked_obsolete_count": markedCount,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
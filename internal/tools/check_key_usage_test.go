@@ -0,0 +1,173 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckKeyUsageTool(t *testing.T) {
+	// Create temporary project
+	tempDir, err := os.MkdirTemp("", "po_key_usage_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	srcDir := filepath.Join(tempDir, "src")
+	require.NoError(t, os.MkdirAll(srcDir, 0755))
+	err = os.WriteFile(filepath.Join(srcDir, "App.tsx"), []byte(`const labels = { save: 'Save' };
+t('Welcome');
+t('Not in catalog');
+`), 0644)
+	require.NoError(t, err)
+
+	poContent := `msgid ""
+msgstr ""
+"Language: fr\n"
+
+#: src/App.tsx:2
+msgid "Welcome"
+msgstr "Bienvenue"
+
+#: src/Old.tsx:4
+msgid "Save"
+msgstr "Enregistrer"
+
+#: src/Old.tsx:8
+msgid "Removed feature"
+msgstr "Fonction supprimée"
+`
+
+	// Get the tool and handler
+	tool, handler := NewCheckKeyUsageTool()
+
+	// Verify tool properties
+	assert.Equal(t, "checkKeyUsage", tool.Name)
+	assert.Contains(t, tool.Description, "orphaned")
+
+	t.Run("Report Orphaned And Missing", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "report.po")
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+		request := makeRequest(map[string]interface{}{
+			"file_path":  poFile,
+			"source_dir": srcDir,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		// "Save" is still found by the text search even though its reference is stale
+		assert.Equal(t, float64(1), resultData["orphaned_count"])
+		orphaned := resultData["orphaned"].([]interface{})
+		assert.Equal(t, "Removed feature", orphaned[0].(map[string]interface{})["msgid"])
+
+		assert.Equal(t, float64(1), resultData["missing_count"])
+		missing := resultData["missing"].([]interface{})
+		assert.Equal(t, "Not in catalog", missing[0].(map[string]interface{})["msgid"])
+
+		// Nothing is written without mark_obsolete
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(content))
+	})
+
+	t.Run("Mark Obsolete", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "obsolete.po")
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+		request := makeRequest(map[string]interface{}{
+			"file_path":     poFile,
+			"source_dir":    srcDir,
+			"mark_obsolete": "true",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), resultData["marked_obsolete_count"])
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "#~ msgid \"Removed feature\"\n#~ msgstr \"Fonction supprimée\"")
+		assert.Contains(t, contentStr, "msgid \"Save\"\nmsgstr \"Enregistrer\"")
+		assert.NotContains(t, contentStr, "src/Old.tsx:8")
+	})
+
+	t.Run("Keep Entries Of Unscanned Files", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "server"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "server", "mail.py"), []byte("subject = _(\"Your invoice\")\n"), 0644))
+		poFile := filepath.Join(tempDir, "server.po")
+		require.NoError(t, os.WriteFile(poFile, []byte("#: server/mail.py:1\nmsgid \"Your invoice\"\nmsgstr \"Votre facture\"\n"), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":     poFile,
+			"source_dir":    srcDir,
+			"mark_obsolete": "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(0), resultData["orphaned_count"])
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "#~")
+	})
+
+	t.Run("Invalid Mark Obsolete", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":     filepath.Join(tempDir, "report.po"),
+			"source_dir":    srcDir,
+			"mark_obsolete": "maybe",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid mark_obsolete value")
+	})
+
+	t.Run("Non-existent File", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":  "/non/existent/file.po",
+			"source_dir": srcDir,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error parsing PO file")
+	})
+
+	t.Run("Missing Parameters", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"source_dir": srcDir,
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file_path parameter is required")
+
+		_, err = handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": "/some/file.po",
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "source_dir parameter is required")
+	})
+}
@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewCheckPluralFormsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("checkPluralForms",
+		mcp.WithDescription("Check the Plural-Forms header of a PO file. Evaluates the plural expression, shows which sample numbers map to each form index, compares it with the expected rules for the file's language and lists plural entries with the wrong number of msgstr forms. Can fix the header."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Language to compare against, defaults to the Language header of the file"),
+		),
+		mcp.WithString("fix",
+			mcp.Description("Set to 'true' to replace the Plural-Forms header with the expected rules for the language. Plural translations whose forms are only ordered differently are reordered; the header is left alone when the forms cannot be carried over"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the fix instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+		language := request.GetString("language", "")
+
+		fix, err := strconv.ParseBool(request.GetString("fix", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid fix value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		report := service.CheckPluralForms(catalog, language)
+
+		fixed := false
+		diff := ""
+		reordered := 0
+		message := ""
+		if fix && report.Expected == nil {
+			message = "Header not fixed, no plural rules known for the language"
+		} else if fix && !report.MatchesExpected {
+			reordered, err = service.FixPluralForms(catalog, report)
+			if err != nil {
+				message = fmt.Sprintf("Header not fixed, %v", err)
+			} else {
+				if diff, err = saveCatalog(catalog, filePath, dryRun); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+				}
+				if !dryRun {
+					fixed = true
+					report = service.CheckPluralForms(catalog, language)
+				}
+			}
+		}
+
+		result := map[string]any{
+			"file_path": filePath,
+			"report":    report,
+			"fixed":     fixed,
+		}
+		if reordered > 0 {
+			result["reordered_count"] = reordered
+		}
+		if message != "" {
+			result["message"] = message
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,208 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPluralFormsTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_plural_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	poContent := `msgid ""
+msgstr ""
+"Language: ru\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+#, c-format
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d файл"
+msgstr[1] "%d файлов"
+`
+	poFile := filepath.Join(tempDir, "ru.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewCheckPluralFormsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "checkPluralForms", tool.Name)
+	assert.Contains(t, tool.Description, "Plural-Forms")
+
+	t.Run("Report Mismatch", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, false, resultData["fixed"])
+		report := resultData["report"].(map[string]interface{})
+		assert.Equal(t, false, report["matches_expected"])
+		assert.Equal(t, float64(3), report["expected"].(map[string]interface{})["nplurals"])
+		assert.Contains(t, report["samples"], "0")
+
+		// The file is unchanged
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(content))
+	})
+
+	t.Run("Dry Run Fix", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"fix":       "true",
+			"dry_run":   "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, false, resultData["fixed"])
+		assert.Contains(t, resultData["diff"], "-\"Plural-Forms: nplurals=2; plural=(n != 1);\\n\"\n")
+		assert.Contains(t, resultData["diff"], "+\"Plural-Forms: nplurals=3;")
+
+		// The file is unchanged
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(content))
+	})
+
+	t.Run("Fix Header", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"fix":       "true",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, true, resultData["fixed"])
+		report := resultData["report"].(map[string]interface{})
+		assert.Equal(t, true, report["matches_expected"])
+		issues := report["entry_issues"].([]interface{})
+		require.Len(t, issues, 1)
+		assert.Equal(t, "%d file", issues[0].(map[string]interface{})["msgid"])
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"Plural-Forms: nplurals=3; plural=(n%10 == 1 && n%100 != 11 ? 0 :`)
+		assert.Contains(t, string(content), "#, c-format\nmsgid \"%d file\"")
+	})
+
+	t.Run("Reorder Forms", func(t *testing.T) {
+		deFile := filepath.Join(tempDir, "de.po")
+		require.NoError(t, os.WriteFile(deFile, []byte(`msgid ""
+msgstr ""
+"Language: de\n"
+"Plural-Forms: nplurals=2; plural=(n == 1 ? 1 : 0);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d Dateien"
+msgstr[1] "%d Datei"
+`), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": deFile,
+			"fix":       "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, true, resultData["fixed"])
+		assert.Equal(t, float64(1), resultData["reordered_count"])
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "msgstr[0] \"%d Datei\"\nmsgstr[1] \"%d Dateien\"")
+	})
+
+	t.Run("Refuse Different Mapping", func(t *testing.T) {
+		lvContent := `msgid ""
+msgstr ""
+"Language: lv\n"
+"Plural-Forms: nplurals=3; plural=(n%10==0 || (n%100>=11 && n%100<=19) ? 0 : n%10==1 && n%100!=11 ? 1 : 2);\n"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d failu"
+msgstr[1] "%d fails"
+msgstr[2] "%d faili"
+`
+		lvFile := filepath.Join(tempDir, "lv.po")
+		require.NoError(t, os.WriteFile(lvFile, []byte(lvContent), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": lvFile,
+			"fix":       "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, false, resultData["fixed"])
+		assert.Contains(t, resultData["message"], "Header not fixed")
+
+		content, err := os.ReadFile(lvFile)
+		require.NoError(t, err)
+		assert.Equal(t, lvContent, string(content))
+	})
+
+	t.Run("Invalid Fix Value", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"fix":       "maybe",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid fix value")
+	})
+
+	t.Run("Non-existent File", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": "/non/existent/file.po",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error parsing PO file")
+	})
+
+	t.Run("Missing FilePath Parameter", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file_path parameter is required")
+	})
+}
@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewConvertToUTF8Tool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("convertToUTF8",
+		mcp.WithDescription("Convert a PO file from the charset declared in its Content-Type header (such as ISO-8859-1 or CP1251) to UTF-8 and update the header. Files in another charset are read and written in that charset by the other tools, so converting is only needed to add characters the charset cannot represent."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the conversion instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		fromCharset := catalog.Charset()
+		converted := !utils.IsUTF8Charset(fromCharset)
+		message := fmt.Sprintf("%s is already UTF-8", filePath)
+		diff := ""
+		if converted {
+			catalog.SetCharset(utils.DefaultCharset)
+			if diff, err = saveCatalog(catalog, filePath, dryRun); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+			}
+			message = fmt.Sprintf("Converted %s from %s to UTF-8", filePath, fromCharset)
+			if dryRun {
+				message = fmt.Sprintf("Dry run: %s would be converted from %s to UTF-8, nothing was written", filePath, fromCharset)
+			}
+		}
+
+		result := map[string]any{
+			"file_path":    filePath,
+			"from_charset": fromCharset,
+			"to_charset":   utils.DefaultCharset,
+			"converted":    converted,
+			"message":      message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
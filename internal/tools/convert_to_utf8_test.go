@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertToUTF8Tool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_convert_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// A Russian catalog encoded in CP1251
+	poContent := "msgid \"\"\nmsgstr \"\"\n\"Language: ru\\n\"\n\"Content-Type: text/plain; charset=CP1251\\n\"\n\nmsgid \"Hello\"\nmsgstr \"\xcf\xf0\xe8\xe2\xe5\xf2\"\n"
+	poFile := filepath.Join(tempDir, "ru.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewConvertToUTF8Tool()
+
+	// Verify tool properties
+	assert.Equal(t, "convertToUTF8", tool.Name)
+	assert.Contains(t, tool.Description, "UTF-8")
+
+	t.Run("Convert File", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, true, resultData["converted"])
+		assert.Equal(t, "CP1251", resultData["from_charset"])
+		assert.Equal(t, "UTF-8", resultData["to_charset"])
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `msgstr "Привет"`)
+		assert.Contains(t, string(content), `"Content-Type: text/plain; charset=UTF-8\n"`)
+	})
+
+	t.Run("Already UTF-8", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, false, resultData["converted"])
+		assert.Equal(t, "UTF-8", resultData["from_charset"])
+	})
+
+	t.Run("Missing File", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": filepath.Join(tempDir, "missing.po"),
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewDeleteGlossaryTermTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("deleteGlossaryTerm",
+		mcp.WithDescription("Delete a term from the project glossary."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file or directory inside the project"),
+		),
+		mcp.WithString("term",
+			mcp.Required(),
+			mcp.Description("The source term to delete"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Only delete the term for this language; by default it is deleted in every language"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the glossary file instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+
+		term, err := request.RequireString("term")
+		if err != nil {
+			return nil, fmt.Errorf("term parameter is required: %w", err)
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		projectRoot := utils.FindProjectRoot(path)
+		glossary, err := service.LoadGlossary(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading glossary: %v", err)), nil
+		}
+
+		removed := glossary.Delete(term, request.GetString("language", ""))
+		if removed == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Term %q not found in the glossary", term)), nil
+		}
+
+		diff := ""
+		if dryRun {
+			diff, err = glossary.Diff(projectRoot)
+		} else {
+			err = glossary.Save(projectRoot)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error writing glossary: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"project_root":  projectRoot,
+			"deleted_count": removed,
+			"term_count":    len(glossary.Terms),
+			"message":       fmt.Sprintf("Deleted %d glossary entries for %q", removed, term),
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
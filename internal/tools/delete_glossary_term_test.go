@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteGlossaryTermTool(t *testing.T) {
+	tempDir := writeTestGlossary(t, "po_glossary_delete_test")
+	defer os.RemoveAll(tempDir)
+
+	// Get the tool and handler
+	tool, handler := NewDeleteGlossaryTermTool()
+
+	// Verify tool properties
+	assert.Equal(t, "deleteGlossaryTerm", tool.Name)
+	assert.Contains(t, tool.Description, "glossary")
+
+	t.Run("Delete One Language", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":     tempDir,
+			"term":     "Workspace",
+			"language": "fr",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), resultData["deleted_count"])
+		assert.Equal(t, float64(3), resultData["term_count"])
+
+		content, err := os.ReadFile(filepath.Join(tempDir, ".i18n-mcp", "glossary.json"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "espace de travail")
+		assert.Contains(t, string(content), "Arbeitsbereich")
+	})
+
+	t.Run("Unknown Term", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path": tempDir,
+			"term": "Billing Owner",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "not found")
+	})
+
+	t.Run("Missing Parameters", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{"term": "Seat"}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "path parameter is required")
+
+		_, err = handler(context.Background(), makeRequest(map[string]interface{}{"path": tempDir}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "term parameter is required")
+	})
+}
@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+)
+
+func NewDeleteKeyTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("deleteKey",
+		mcp.WithDescription("Delete a msgid (and msgctxt) from every PO file of a directory, such as a string removed from the source code. Use dry_run to see the affected files and translations first. Deletions are recorded in the journal and can be undone with revertChanges."),
+		mcp.WithString("directory",
+			mcp.Required(),
+			mcp.Description("The directory to scan for .po files"),
+		),
+		mcp.WithString("msgid",
+			mcp.Required(),
+			mcp.Description("The msgid to delete"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The msgctxt of the key"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to report the affected files with a unified diff instead of writing them (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		directory, err := request.RequireString("directory")
+		if err != nil {
+			return nil, fmt.Errorf("directory parameter is required: %w", err)
+		}
+
+		msgid, err := request.RequireString("msgid")
+		if err != nil {
+			return nil, fmt.Errorf("msgid parameter is required: %w", err)
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+		msgctxt := request.GetString("context", "")
+
+		catalogFiles, skipped, err := parseDirectoryCatalogs(directory)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+		}
+
+		changes := []service.KeyChange{}
+		for _, file := range catalogFiles {
+			change := service.DeleteKey(file.Catalog, file.Path, msgctxt, msgid)
+			change.Language = file.Language
+			if change.Status == service.KeyDeleted {
+				changes = append(changes, change)
+			}
+		}
+
+		diff, writeErrors := saveKeyChanges(ctx, tool.Name, catalogFiles, changes, dryRun)
+
+		message := fmt.Sprintf("Deleted the key from %d files", len(changes))
+		if dryRun {
+			message = fmt.Sprintf("Dry run: the key would be deleted from %d files, nothing was written", len(changes))
+		}
+		if writeErrors > 0 {
+			message += fmt.Sprintf(". %d files could not be written", writeErrors)
+		}
+
+		result := map[string]any{
+			"directory":     directory,
+			"context":       msgctxt,
+			"msgid":         msgid,
+			"deleted_count": len(changes),
+			"files":         changes,
+			"message":       message,
+		}
+		if len(skipped) > 0 {
+			result["skipped_files"] = skipped
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteKeyTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_delete_key_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	deFile := filepath.Join(tempDir, "locale", "de", "LC_MESSAGES", "app.po")
+	frFile := filepath.Join(tempDir, "locale", "fr", "LC_MESSAGES", "app.po")
+	deContent := "msgid \"Save\"\nmsgstr \"Speichern\"\n\nmsgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Öffnen\"\n"
+	for path, content := range map[string]string{
+		deFile: deContent,
+		frFile: "msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Ouvrir\"\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	// Get the tool and handler
+	tool, handler := NewDeleteKeyTool()
+
+	// Verify tool properties
+	assert.Equal(t, "deleteKey", tool.Name)
+	assert.Contains(t, tool.Description, "Delete a msgid")
+
+	deleteKey := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("Dry Run", func(t *testing.T) {
+		resultData := deleteKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Open",
+			"context":   "menu",
+			"dry_run":   "true",
+		})
+		assert.Equal(t, float64(2), resultData["deleted_count"])
+		assert.Contains(t, resultData["diff"], "-msgstr \"Ouvrir\"\n")
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Equal(t, deContent, string(content))
+	})
+
+	t.Run("Context Must Match", func(t *testing.T) {
+		resultData := deleteKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Open",
+		})
+		assert.Equal(t, float64(0), resultData["deleted_count"])
+	})
+
+	t.Run("Delete Everywhere", func(t *testing.T) {
+		resultData := deleteKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Open",
+			"context":   "menu",
+		})
+		assert.Equal(t, float64(2), resultData["deleted_count"])
+		file := resultData["files"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "deleted", file["status"])
+		assert.Equal(t, []interface{}{"Öffnen"}, file["msgstr"])
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Equal(t, "msgid \"Save\"\nmsgstr \"Speichern\"\n", string(content))
+		content, err = os.ReadFile(frFile)
+		require.NoError(t, err)
+		assert.Empty(t, string(content))
+	})
+
+	t.Run("Undo Plural Delete With Revert", func(t *testing.T) {
+		plFile := filepath.Join(tempDir, "locale", "pl", "LC_MESSAGES", "app.po")
+		plContent := `msgid "Save"
+msgstr "Zapisz"
+
+#. Shown in the toolbar
+#: src/files.js:12
+#, c-format
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d plik"
+msgstr[1] "%d pliki"
+msgstr[2] "%d plików"
+
+msgid "Close"
+msgstr "Zamknij"
+`
+		require.NoError(t, os.MkdirAll(filepath.Dir(plFile), 0755))
+		require.NoError(t, os.WriteFile(plFile, []byte(plContent), 0644))
+
+		resultData := deleteKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "%d file",
+		})
+		assert.Equal(t, float64(1), resultData["deleted_count"])
+
+		_, revertChanges := NewRevertChangesTool()
+		result, err := revertChanges(context.Background(), makeRequest(map[string]interface{}{
+			"path":  plFile,
+			"msgid": "%d file",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		// The plural forms, comments and position of the entry are restored
+		content, err := os.ReadFile(plFile)
+		require.NoError(t, err)
+		assert.Equal(t, plContent, string(content))
+	})
+}
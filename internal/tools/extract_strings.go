@@ -0,0 +1,156 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewExtractStringsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("extractStrings",
+		mcp.WithDescription("Extract translatable strings from JavaScript/TypeScript/JSX/TSX source files and write them to a .pot or .po file. New strings are added with an empty translation so they show up in getUntranslatedTerms; existing entries get their references and comments refreshed."),
+		mcp.WithString("source_dir",
+			mcp.Required(),
+			mcp.Description("The source directory to scan"),
+		),
+		mcp.WithString("output_path",
+			mcp.Required(),
+			mcp.Description("The .pot or .po file to create or update"),
+		),
+		mcp.WithString("keywords",
+			mcp.Description("Comma-separated translation functions in xgettext notation, e.g. \"t,i18n._,__,ngettext:1,2,pgettext:1c,2\" (default: "+strings.Join(utils.DefaultKeywordSpecs, " ")+")"),
+		),
+		mcp.WithString("components",
+			mcp.Description("Comma-separated JSX component names whose content is translatable (default: Trans)"),
+		),
+		mcp.WithString("extensions",
+			mcp.Description("Comma-separated file extensions to scan (default: .js,.jsx,.ts,.tsx,.mjs,.cjs)"),
+		),
+		mcp.WithString("comment_tag",
+			mcp.Description("Only keep comments above a string that start with this tag, e.g. \"translators:\" (default: keep every adjacent comment)"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the output file instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sourceDir, err := request.RequireString("source_dir")
+		if err != nil {
+			return nil, fmt.Errorf("source_dir parameter is required: %w", err)
+		}
+
+		outputPath, err := request.RequireString("output_path")
+		if err != nil {
+			return nil, fmt.Errorf("output_path parameter is required: %w", err)
+		}
+
+		opts, err := extractOptionsFromRequest(request)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid keywords value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		messages, err := utils.ExtractFromDirectory(sourceDir, opts)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error extracting strings: %v", err)), nil
+		}
+
+		// Update the existing catalog or start a new template
+		catalog := utils.NewTemplateCatalog()
+		if _, statErr := os.Stat(outputPath); statErr == nil {
+			catalog, err = utils.ParseCatalogFile(outputPath)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+			}
+		}
+
+		added := utils.MergeIntoCatalog(catalog, messages)
+
+		diff, err := saveCatalog(catalog, outputPath, dryRun)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"source_dir":      sourceDir,
+			"output_path":     outputPath,
+			"extracted_count": len(messages),
+			"added_count":     len(added),
+			"added":           added,
+			"message":         fmt.Sprintf("Extracted %d strings (%d new) and saved to %s", len(messages), len(added), outputPath),
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+			result["message"] = fmt.Sprintf("Dry run: extracted %d strings (%d new), nothing was written to %s", len(messages), len(added), outputPath)
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+// extractOptionsFromRequest builds extractor options from the optional keywords,
+// components, extensions and comment_tag parameters
+func extractOptionsFromRequest(request mcp.CallToolRequest) (utils.ExtractOptions, error) {
+	opts := utils.DefaultExtractOptions()
+	if keywordsStr := request.GetString("keywords", ""); keywordsStr != "" {
+		keywords, err := utils.ParseKeywords(splitKeywordSpecs(keywordsStr))
+		if err != nil {
+			return opts, err
+		}
+		opts.Keywords = keywords
+	}
+	if components := splitList(request.GetString("components", "")); len(components) > 0 {
+		opts.Components = components
+	}
+	if extensions := splitList(request.GetString("extensions", "")); len(extensions) > 0 {
+		opts.Extensions = extensions
+	}
+	opts.CommentTag = request.GetString("comment_tag", "")
+	return opts, nil
+}
+
+// splitList splits a comma-separated parameter, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// splitKeywordSpecs splits comma-separated keyword specs while keeping the argument
+// positions of a spec together, so "ngettext:1,2,t" yields "ngettext:1,2" and "t"
+func splitKeywordSpecs(value string) []string {
+	var specs []string
+	for _, item := range splitList(value) {
+		isPosition := strings.TrimRight(strings.TrimSuffix(item, "c"), "0123456789") == ""
+		if isPosition && len(specs) > 0 && strings.Contains(specs[len(specs)-1], ":") {
+			specs[len(specs)-1] += "," + item
+			continue
+		}
+		specs = append(specs, item)
+	}
+	return specs
+}
@@ -0,0 +1,172 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractStringsTool(t *testing.T) {
+	// Create temporary source tree
+	tempDir, err := os.MkdirTemp("", "po_extract_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	srcDir := filepath.Join(tempDir, "src")
+	require.NoError(t, os.MkdirAll(srcDir, 0755))
+	err = os.WriteFile(filepath.Join(srcDir, "App.tsx"), []byte(`export function App() {
+  // translators: main heading
+  const title = t('Welcome');
+  return <Trans>Hello <b>world</b></Trans>;
+}
+`), 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(srcDir, "util.js"), []byte(`translate('ns', 'Custom keyword');`), 0644)
+	require.NoError(t, err)
+
+	// Get the tool and handler
+	tool, handler := NewExtractStringsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "extractStrings", tool.Name)
+	assert.Contains(t, tool.Description, "Extract translatable strings")
+
+	t.Run("Create POT File", func(t *testing.T) {
+		potFile := filepath.Join(tempDir, "messages.pot")
+
+		request := makeRequest(map[string]interface{}{
+			"source_dir":  srcDir,
+			"output_path": potFile,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(2), resultData["extracted_count"])
+		assert.Equal(t, float64(2), resultData["added_count"])
+
+		content, err := os.ReadFile(potFile)
+		require.NoError(t, err)
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "#. translators: main heading\n#: App.tsx:3\nmsgid \"Welcome\"\nmsgstr \"\"")
+		assert.Contains(t, contentStr, `msgid "Hello <b>world</b>"`)
+		assert.Contains(t, contentStr, "charset=UTF-8")
+	})
+
+	t.Run("Update Existing PO File", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "fr.po")
+		err := os.WriteFile(poFile, []byte(`msgid ""
+msgstr ""
+"Language: fr\n"
+
+#: old.tsx:1
+msgid "Welcome"
+msgstr "Bienvenue"
+`), 0644)
+		require.NoError(t, err)
+
+		request := makeRequest(map[string]interface{}{
+			"source_dir":  srcDir,
+			"output_path": poFile,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(1), resultData["added_count"])
+		assert.Equal(t, []interface{}{"Hello <b>world</b>"}, resultData["added"])
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "msgstr \"Bienvenue\"")
+		assert.Contains(t, contentStr, "#: App.tsx:3")
+		assert.NotContains(t, contentStr, "old.tsx:1")
+
+		// The new string flows into getUntranslatedTerms
+		_, untranslatedHandler := NewGetUntranslatedTermsTool()
+		untranslatedResult, err := untranslatedHandler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextContent(t, untranslatedResult), "Hello \\u003cb\\u003eworld\\u003c/b\\u003e")
+	})
+
+	t.Run("Custom Keywords", func(t *testing.T) {
+		potFile := filepath.Join(tempDir, "custom.pot")
+
+		request := makeRequest(map[string]interface{}{
+			"source_dir":  srcDir,
+			"output_path": potFile,
+			"keywords":    "translate:2",
+			"components":  "FormattedMessage",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		content, err := os.ReadFile(potFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `msgid "Custom keyword"`)
+		assert.NotContains(t, string(content), `msgid "Welcome"`)
+	})
+
+	t.Run("Invalid Keywords", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"source_dir":  srcDir,
+			"output_path": filepath.Join(tempDir, "invalid.pot"),
+			"keywords":    "t:x",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid keywords value")
+	})
+
+	t.Run("Non-existent Source Directory", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"source_dir":  "/path/that/does/not/exist",
+			"output_path": filepath.Join(tempDir, "missing.pot"),
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error extracting strings")
+	})
+
+	t.Run("Missing Parameters", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"output_path": "/some/file.pot",
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "source_dir parameter is required")
+
+		_, err = handler(context.Background(), makeRequest(map[string]interface{}{
+			"source_dir": srcDir,
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "output_path parameter is required")
+	})
+}
+
+func TestSplitKeywordSpecs(t *testing.T) {
+	assert.Equal(t, []string{"t", "ngettext:1,2", "pgettext:1c,2", "__"}, splitKeywordSpecs("t, ngettext:1,2,pgettext:1c,2, __"))
+}
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+)
+
+func NewGenerateReportTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("generateReport",
+		mcp.WithDescription("Generate a translation dashboard for every PO file in a directory: a language by domain completion matrix with progress bars and fuzzy counts, totals per language and the oldest untranslated entries. Renders Markdown, a self-contained HTML page or JSON, returned as text or written to output_path."),
+		mcp.WithString("directory",
+			mcp.Required(),
+			mcp.Description("The directory to scan for .po files"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Report format: markdown, html or json (default: markdown)"),
+		),
+		mcp.WithString("output_path",
+			mcp.Description("Write the report to this file instead of returning it"),
+		),
+		mcp.WithString("oldest",
+			mcp.Description("Number of oldest untranslated entries to list (default: 10)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		directory, err := request.RequireString("directory")
+		if err != nil {
+			return nil, fmt.Errorf("directory parameter is required: %w", err)
+		}
+
+		format := request.GetString("format", service.ReportMarkdown)
+		if !service.IsReportFormat(format) {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid format value: %s", format)), nil
+		}
+
+		oldest, err := strconv.Atoi(request.GetString("oldest", "10"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid oldest value: %v", err)), nil
+		}
+		outputPath := request.GetString("output_path", "")
+
+		report, err := service.BuildReport(directory, oldest)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+		}
+
+		content, err := report.Render(format)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error rendering report: %v", err)), nil
+		}
+		if outputPath == "" {
+			return mcp.NewToolResultText(content), nil
+		}
+
+		if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error writing report: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"directory":   directory,
+			"format":      format,
+			"output_path": outputPath,
+			"languages":   len(report.Languages),
+			"domains":     len(report.Domains),
+			"message":     fmt.Sprintf("Wrote the %s report of %d languages and %d domains to %s", format, len(report.Languages), len(report.Domains), outputPath),
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
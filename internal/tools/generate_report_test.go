@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateReportTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_report_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.fr.po"), []byte("msgid \"Save\"\nmsgstr \"Enregistrer\"\n\nmsgid \"Open\"\nmsgstr \"\"\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.de.po"), []byte("msgid \"Save\"\nmsgstr \"Speichern\"\n"), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewGenerateReportTool()
+
+	// Verify tool properties
+	assert.Equal(t, "generateReport", tool.Name)
+	assert.Contains(t, tool.Description, "completion matrix")
+
+	t.Run("Markdown", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		content := getTextContent(t, result)
+		assert.Contains(t, content, "# Translation Report")
+		assert.Contains(t, content, "| fr | █████░░░░░ 50.0% |")
+		assert.Contains(t, content, "| Open |")
+	})
+
+	t.Run("Write HTML", func(t *testing.T) {
+		outputPath := filepath.Join(tempDir, "report.html")
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory":   tempDir,
+			"format":      "html",
+			"output_path": outputPath,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(getTextContent(t, result)), &resultData))
+		assert.Equal(t, float64(2), resultData["languages"])
+		assert.Equal(t, float64(1), resultData["domains"])
+
+		content, err := os.ReadFile(outputPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "<h1>Translation Report</h1>")
+	})
+
+	t.Run("Invalid Format", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+			"format":    "pdf",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid format value")
+	})
+}
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewGetStatisticsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("getStatistics",
+		mcp.WithDescription("Report translation progress of a PO file or of every PO file in a directory. Returns the number and percentage of translated, fuzzy and untranslated entries, the obsolete entries, and source and target word and character counts for each file, for each language and in total. Use it to answer questions such as how far along French is."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file, or a directory to scan for .po files"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Only count the files of this language when scanning a directory"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+		language := request.GetString("language", "")
+
+		var catalogFiles []service.CatalogFile
+		skipped := []string{}
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			catalogFiles, skipped, err = service.ReadCatalogFiles(path)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+			}
+		} else {
+			catalog, err := utils.ReadCatalog(path)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+			}
+			info := utils.NewPoFileInfo(path, catalog.Language())
+			catalogFiles = []service.CatalogFile{{Path: path, Language: info.Language, Catalog: catalog}}
+		}
+
+		if language != "" {
+			matching := []service.CatalogFile{}
+			for _, file := range catalogFiles {
+				if utils.LanguagesMatch(language, file.Language) {
+					matching = append(matching, file)
+				}
+			}
+			catalogFiles = matching
+		}
+
+		files, languages := service.CollectStatistics(catalogFiles)
+		var total service.Statistics
+		for _, languageStats := range languages {
+			total.Add(languageStats.Statistics)
+		}
+
+		result := map[string]any{
+			"path":      path,
+			"files":     files,
+			"languages": languages,
+			"total":     total,
+		}
+		if len(skipped) > 0 {
+			result["skipped_files"] = skipped
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
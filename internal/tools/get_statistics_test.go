@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStatisticsTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_statistics_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	frApp := filepath.Join(tempDir, "locale", "fr", "app.po")
+	frAdmin := filepath.Join(tempDir, "locale", "fr", "admin.po")
+	deApp := filepath.Join(tempDir, "locale", "de", "app.po")
+	for path, content := range map[string]string{
+		frApp:   "msgid \"Save\"\nmsgstr \"Enregistrer\"\n\nmsgid \"Open\"\nmsgstr \"\"\n",
+		frAdmin: "#, fuzzy\nmsgid \"Delete user\"\nmsgstr \"Supprimer\"\n\nmsgid \"Users\"\nmsgstr \"Utilisateurs\"\n",
+		deApp:   "msgid \"Save\"\nmsgstr \"Speichern\"\n\nmsgid \"Open\"\nmsgstr \"Öffnen\"\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	// Get the tool and handler
+	tool, handler := NewGetStatisticsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "getStatistics", tool.Name)
+	assert.Contains(t, tool.Description, "translation progress")
+
+	getStatistics := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("Directory", func(t *testing.T) {
+		resultData := getStatistics(t, map[string]interface{}{"path": tempDir})
+		assert.Len(t, resultData["files"], 3)
+
+		languages := resultData["languages"].([]interface{})
+		require.Len(t, languages, 2)
+		de := languages[0].(map[string]interface{})
+		assert.Equal(t, "de", de["language"])
+		assert.Equal(t, float64(100), de["translated_percent"])
+		fr := languages[1].(map[string]interface{})
+		assert.Equal(t, float64(2), fr["files"])
+		assert.Equal(t, float64(4), fr["total"])
+		assert.Equal(t, float64(2), fr["translated"])
+		assert.Equal(t, float64(1), fr["fuzzy"])
+		assert.Equal(t, float64(1), fr["untranslated"])
+		assert.Equal(t, float64(50), fr["translated_percent"])
+
+		total := resultData["total"].(map[string]interface{})
+		assert.Equal(t, float64(6), total["total"])
+		assert.Equal(t, float64(66.7), total["translated_percent"])
+	})
+
+	t.Run("Language Filter", func(t *testing.T) {
+		resultData := getStatistics(t, map[string]interface{}{"path": tempDir, "language": "fr"})
+		assert.Len(t, resultData["files"], 2)
+		assert.Len(t, resultData["languages"], 1)
+	})
+
+	t.Run("Single File", func(t *testing.T) {
+		resultData := getStatistics(t, map[string]interface{}{"path": deApp})
+		files := resultData["files"].([]interface{})
+		require.Len(t, files, 1)
+		file := files[0].(map[string]interface{})
+		assert.Equal(t, deApp, file["file"])
+		assert.Equal(t, float64(2), file["source_words"])
+		assert.Equal(t, float64(15), file["target_characters"])
+	})
+
+	t.Run("Missing File", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path": filepath.Join(tempDir, "missing.po"),
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error parsing PO file")
+	})
+}
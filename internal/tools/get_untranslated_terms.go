@@ -14,7 +14,7 @@ import (
 
 func NewGetUntranslatedTermsTool() (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("getUntranslatedTerms",
-		mcp.WithDescription("Get untranslated terms from a PO file. After translating, you can use this tool to check if all terms are translated."),
+		mcp.WithDescription("Get untranslated terms from a PO file. Fuzzy terms are listed too, with their fuzzy translation as the value. After translating, you can use this tool to check if all terms are translated. Terms with a maximum translation length are listed in constraints, and glossary terms found in each term are listed in glossary. Pass reference_languages to see the translations of the same terms in sibling catalogs of other languages, such as es when translating pt. Set suggestions to include similar translations from the other catalogs of the same language. Where a term or reference has recorded provenance (source, client and time of its last translation) it is listed too."),
 		mcp.WithString("file_path",
 			mcp.Required(),
 			mcp.Description("The path to the .po file"),
@@ -22,6 +22,12 @@ func NewGetUntranslatedTermsTool() (mcp.Tool, server.ToolHandlerFunc) {
 		mcp.WithString("limit",
 			mcp.Description("Number of untranslated terms to return (default: 10)"),
 		),
+		mcp.WithString("reference_languages",
+			mcp.Description("Comma-separated languages whose catalogs of the same domain are searched for existing translations of each term, listed in references (e.g. 'es,it')"),
+		),
+		mcp.WithString("suggestions",
+			mcp.Description("Set to 'true' to include up to 3 translation memory suggestions for each term (default: false)"),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -37,17 +43,67 @@ func NewGetUntranslatedTermsTool() (mcp.Tool, server.ToolHandlerFunc) {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid limit value: %v", err)), nil
 		}
 
-		// Parse the PO file
-		po, err := utils.ParsePoFile(filePath)
+		withSuggestions, err := strconv.ParseBool(request.GetString("suggestions", "false"))
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid suggestions value: %v", err)), nil
 		}
+		referenceLanguages := splitList(request.GetString("reference_languages", ""))
 
-		// Create PoService instance
-		poService := service.NewPoService(po)
+		// Read the PO file through the catalog index
+		catalog, err := utils.ReadCatalog(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
 
 		// Get untranslated terms
-		untranslatedTerms := poService.ListAllUntranslated(limit)
+		untranslatedTerms := service.ListUntranslated(catalog, limit)
+
+		// Collect the length limits the translations of the terms must respect
+		projectRoot := utils.FindProjectRoot(filePath)
+		projectConstraints, err := service.LoadConstraints(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading constraints: %v", err)), nil
+		}
+		glossary, err := service.LoadGlossary(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading glossary: %v", err)), nil
+		}
+
+		// Catalogs of the same domain in the reference languages
+		var siblings []service.CatalogFile
+		if len(referenceLanguages) > 0 {
+			catalogFiles, _, err := service.ReadCatalogFiles(projectRoot)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+			}
+			siblings = service.SiblingCatalogs(catalogFiles, filePath, referenceLanguages)
+		}
+
+		language := utils.NewPoFileInfo(filePath, catalog.Language()).Language
+		constraints := make(map[string]service.EntryConstraints)
+		glossaryHits := make(map[string][]service.GlossaryTerm)
+		references := make(map[string][]service.LanguageTranslation)
+		provenance := make(map[string]*service.Provenance)
+		for _, entry := range catalog.ActiveEntries() {
+			if _, ok := untranslatedTerms.Terms[entry.ID]; !ok {
+				continue
+			}
+			// Partly translated or reverted entries may still tell where their last translation came from
+			if entryProvenance := service.GetProvenance(entry); entryProvenance != nil {
+				provenance[entry.ID] = entryProvenance
+			}
+			if entryConstraints := projectConstraints.ForEntry(entry); entryConstraints.MaxLength > 0 {
+				constraints[entry.ID] = entryConstraints
+			}
+			if terms := glossary.Matches(language, entry.ID+"\n"+entry.PluralID); len(terms) > 0 {
+				glossaryHits[entry.ID] = terms
+			}
+			for _, translation := range service.MessageTranslations(siblings, entry.Context, entry.ID) {
+				if translation.Translated && !translation.Fuzzy {
+					references[entry.ID] = append(references[entry.ID], translation)
+				}
+			}
+		}
 
 		// Create result object
 		result := map[string]any{
@@ -56,6 +112,26 @@ func NewGetUntranslatedTermsTool() (mcp.Tool, server.ToolHandlerFunc) {
 			"count":              len(untranslatedTerms.Terms),
 			"language":           untranslatedTerms.Language,
 			"untranslated_terms": untranslatedTerms.Terms,
+			"constraints":        constraints,
+			"glossary":           glossaryHits,
+			"provenance":         provenance,
+		}
+		if len(referenceLanguages) > 0 {
+			result["references"] = references
+		}
+
+		if withSuggestions {
+			memory, err := service.BuildTranslationMemory(projectRoot, language)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error building translation memory: %v", err)), nil
+			}
+			suggestions := make(map[string][]service.Suggestion)
+			for msgid := range untranslatedTerms.Terms {
+				if termSuggestions := memory.Suggest(msgid, 3, service.DefaultMinScore); len(termSuggestions) > 0 {
+					suggestions[msgid] = termSuggestions
+				}
+			}
+			result["suggestions"] = suggestions
 		}
 
 		resultJSON, err := json.MarshalIndent(result, "", "  ")
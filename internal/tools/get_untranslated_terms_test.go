@@ -174,3 +174,140 @@ msgstr "adiós"
 		assert.Empty(t, untranslatedTerms)
 	})
 }
+
+func TestGetUntranslatedTermsConstraints(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_untranslated_constraints_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	configDir := filepath.Join(tempDir, ".i18n-mcp")
+	require.NoError(t, os.MkdirAll(configDir, 0755))
+	constraints := `{"max_length": [{"msgid": "Save", "max_length": 8}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "constraints.json"), []byte(constraints), 0644))
+
+	poContent := `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgid "Save"
+msgstr ""
+
+#, max-length:30
+msgid "New message"
+msgstr ""
+
+msgid "Welcome"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "de.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	_, handler := NewGetUntranslatedTermsTool()
+	result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+		"file_path": poFile,
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var resultData map[string]interface{}
+	err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+	require.NoError(t, err)
+
+	constraintsData := resultData["constraints"].(map[string]interface{})
+	assert.Len(t, constraintsData, 2)
+	assert.Equal(t, map[string]interface{}{"max_length": float64(8), "source": "constraints.json"}, constraintsData["Save"])
+	assert.Equal(t, map[string]interface{}{"max_length": float64(30), "source": "flag"}, constraintsData["New message"])
+}
+
+func TestGetUntranslatedTermsGlossary(t *testing.T) {
+	tempDir := writeTestGlossary(t, "po_untranslated_glossary_test")
+	defer os.RemoveAll(tempDir)
+
+	// Without a Language header the language of the file name selects the terms
+	poContent := `msgid "Open workspace"
+msgstr ""
+
+msgid "Welcome"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "de.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	_, handler := NewGetUntranslatedTermsTool()
+	result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+		"file_path": poFile,
+	}))
+	require.NoError(t, err)
+
+	var resultData map[string]interface{}
+	err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+	require.NoError(t, err)
+
+	glossary := resultData["glossary"].(map[string]interface{})
+	require.Len(t, glossary, 1)
+	terms := glossary["Open workspace"].([]interface{})
+	require.Len(t, terms, 1)
+	assert.Equal(t, "Arbeitsbereich", terms[0].(map[string]interface{})["translation"])
+}
+
+func TestGetUntranslatedTermsSuggestions(t *testing.T) {
+	tempDir := writeTestMemoryProject(t, "po_untranslated_suggestions_test")
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewGetUntranslatedTermsTool()
+	result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+		"file_path":   filepath.Join(tempDir, "web", "de.po"),
+		"suggestions": "true",
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var resultData map[string]interface{}
+	err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+	require.NoError(t, err)
+
+	// Terms without similar translations are left out
+	suggestions := resultData["suggestions"].(map[string]interface{})
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "Datei löschen", suggestions["Delete files"].([]interface{})[0].(map[string]interface{})["translation"])
+}
+
+func TestGetUntranslatedTermsReferenceLanguages(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_untranslated_references_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"package.json":                    "{}",
+		"locale/es/LC_MESSAGES/app.po":    "msgid \"Save changes\"\nmsgstr \"Guardar cambios\"\n\nmsgid \"Open\"\nmsgstr \"\"\n",
+		"locale/es/LC_MESSAGES/admin.po":  "msgid \"Save changes\"\nmsgstr \"Guardar\"\n",
+		"locale/it/LC_MESSAGES/app.po":    "#, fuzzy\nmsgid \"Save changes\"\nmsgstr \"Salva\"\n",
+		"locale/pt_BR/LC_MESSAGES/app.po": "msgid \"Save changes\"\nmsgstr \"\"\n\nmsgid \"Open\"\nmsgstr \"\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	_, handler := NewGetUntranslatedTermsTool()
+	result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+		"file_path":           filepath.Join(tempDir, "locale", "pt_BR", "LC_MESSAGES", "app.po"),
+		"reference_languages": "es,it",
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var resultData map[string]interface{}
+	err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+	require.NoError(t, err)
+
+	// Only translated, non-fuzzy entries of the same domain are references
+	references := resultData["references"].(map[string]interface{})
+	require.Len(t, references, 1)
+	saveChanges := references["Save changes"].([]interface{})
+	require.Len(t, saveChanges, 1)
+	reference := saveChanges[0].(map[string]interface{})
+	assert.Equal(t, "es", reference["language"])
+	assert.Equal(t, []interface{}{"Guardar cambios"}, reference["msgstr"])
+}
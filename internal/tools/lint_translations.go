@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewLintTranslationsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	checkIDs := make([]string, 0, len(service.LintChecks))
+	for _, check := range service.LintChecks {
+		checkIDs = append(checkIDs, check.ID)
+	}
+
+	tool := mcp.NewTool("lintTranslations",
+		mcp.WithDescription("Lint the translations of a PO file. Runs checks for whitespace, newlines, end punctuation, untranslated copies, doubled spaces, capitalization, numbers, URLs, email addresses and markup, and returns the issues grouped by severity and check ID. Available checks: "+strings.Join(checkIDs, ", ")),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file"),
+		),
+		mcp.WithString("checks",
+			mcp.Description("Comma-separated check IDs to run (default: all checks)"),
+		),
+		mcp.WithString("disable",
+			mcp.Description("Comma-separated check IDs to skip"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		selected, err := service.SelectLintChecks(splitList(request.GetString("checks", "")), splitList(request.GetString("disable", "")))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid checks: %v", err)), nil
+		}
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		checked := 0
+		grouped := map[string]map[string][]service.ValidationIssue{
+			service.SeverityError:   {},
+			service.SeverityWarning: {},
+		}
+		summary := map[string]map[string]int{
+			service.SeverityError:   {},
+			service.SeverityWarning: {},
+		}
+		errorCount, warningCount := 0, 0
+		for _, entry := range catalog.ActiveEntries() {
+			if entry.Translation() == "" {
+				continue
+			}
+			checked++
+			for _, issue := range service.LintEntry(entry, selected) {
+				grouped[issue.Severity][issue.Check] = append(grouped[issue.Severity][issue.Check], issue)
+				summary[issue.Severity][issue.Check]++
+				if issue.Severity == service.SeverityError {
+					errorCount++
+				} else {
+					warningCount++
+				}
+			}
+		}
+
+		enabled := []string{}
+		for _, id := range checkIDs {
+			if selected[id] {
+				enabled = append(enabled, id)
+			}
+		}
+
+		result := map[string]any{
+			"file_path":     filePath,
+			"language":      catalog.Language(),
+			"checks":        enabled,
+			"checked_count": checked,
+			"error_count":   errorCount,
+			"warning_count": warningCount,
+			"summary":       summary,
+			"issues":        grouped,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
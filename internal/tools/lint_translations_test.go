@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintTranslationsTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_lint_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	poContent := `msgid ""
+msgstr ""
+"Language: fr\n"
+
+msgid "Save your changes."
+msgstr "Enregistrez vos modifications."
+
+msgid "Are you sure?"
+msgstr "êtes-vous sûr"
+
+msgid "See https://example.com/help"
+msgstr "Voir https://example.fr/aide"
+
+msgid "Up to 10 users"
+msgstr "Jusqu'à dix utilisateurs"
+
+msgid "Untranslated"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewLintTranslationsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "lintTranslations", tool.Name)
+	assert.Contains(t, tool.Description, "end_punctuation")
+
+	lint := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("All Checks", func(t *testing.T) {
+		resultData := lint(t, map[string]interface{}{"file_path": poFile})
+
+		assert.Equal(t, float64(4), resultData["checked_count"])
+		assert.Equal(t, float64(1), resultData["error_count"])
+		assert.Equal(t, float64(3), resultData["warning_count"])
+
+		summary := resultData["summary"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"urls": float64(1)}, summary["error"])
+		assert.Equal(t, map[string]interface{}{
+			"end_punctuation": float64(1),
+			"capitalization":  float64(1),
+			"numbers":         float64(1),
+		}, summary["warning"])
+
+		issues := resultData["issues"].(map[string]interface{})
+		urls := issues["error"].(map[string]interface{})["urls"].([]interface{})
+		assert.Equal(t, "See https://example.com/help", urls[0].(map[string]interface{})["msgid"])
+	})
+
+	t.Run("Disable Checks", func(t *testing.T) {
+		resultData := lint(t, map[string]interface{}{
+			"file_path": poFile,
+			"disable":   "capitalization, numbers",
+		})
+		assert.Equal(t, float64(1), resultData["warning_count"])
+		assert.NotContains(t, resultData["checks"], "numbers")
+	})
+
+	t.Run("Selected Checks", func(t *testing.T) {
+		resultData := lint(t, map[string]interface{}{
+			"file_path": poFile,
+			"checks":    "numbers",
+		})
+		assert.Equal(t, []interface{}{"numbers"}, resultData["checks"])
+		assert.Equal(t, float64(0), resultData["error_count"])
+		assert.Equal(t, float64(1), resultData["warning_count"])
+	})
+
+	t.Run("Unknown Check", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"checks":    "spelling",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "unknown check")
+	})
+
+	t.Run("Non-existent File", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": "/non/existent/file.po",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error parsing PO file")
+	})
+
+	t.Run("Missing FilePath Parameter", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file_path parameter is required")
+	})
+}
@@ -12,7 +12,7 @@ import (
 
 func NewListAllPoFilesTool() (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("listAllPoFiles",
-		mcp.WithDescription("List all .po files in the given directory with language information"),
+		mcp.WithDescription("List all .po files in the given directory with language information. Languages are normalized to BCP 47 tags, inferred from the path when the Language header is missing, and files whose path and header name different languages are flagged."),
 		mcp.WithString("directory",
 			mcp.Required(),
 			mcp.Description("The directory path to scan for .po files"),
@@ -31,11 +31,19 @@ func NewListAllPoFilesTool() (mcp.Tool, server.ToolHandlerFunc) {
 			return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
 		}
 
+		mismatches := []string{}
+		for _, info := range poFilesInfo {
+			if info.LanguageMismatch {
+				mismatches = append(mismatches, info.Path)
+			}
+		}
+
 		// Create result object
 		result := map[string]interface{}{
-			"directory": directory,
-			"count":     len(poFilesInfo),
-			"files":     poFilesInfo,
+			"directory":           directory,
+			"count":               len(poFilesInfo),
+			"files":               poFilesInfo,
+			"language_mismatches": mismatches,
 		}
 
 		resultJSON, err := json.MarshalIndent(result, "", "  ")
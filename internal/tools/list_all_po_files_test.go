@@ -102,6 +102,32 @@ msgstr "Principal"`},
 			lang := fileInfo["language"].(string)
 			assert.Contains(t, []string{"en", "es", "fr"}, lang)
 		}
+		assert.Empty(t, resultData["language_mismatches"])
+	})
+
+	// Test a header that contradicts the directory layout
+	t.Run("Language Mismatch", func(t *testing.T) {
+		mismatchDir, err := os.MkdirTemp("", "po_mismatch_test")
+		require.NoError(t, err)
+		defer os.RemoveAll(mismatchDir)
+
+		poFile := filepath.Join(mismatchDir, "fr.po")
+		require.NoError(t, os.WriteFile(poFile, []byte("msgid \"\"\nmsgstr \"\"\n\"Language: de_DE\\n\"\n"), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": mismatchDir,
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, []interface{}{poFile}, resultData["language_mismatches"])
+		fileInfo := resultData["files"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "de-DE", fileInfo["language"])
+		assert.Equal(t, "de_DE", fileInfo["header_language"])
+		assert.Equal(t, "fr", fileInfo["path_language"])
 	})
 
 	// Test with non-existent directory
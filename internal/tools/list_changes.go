@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewListChangesTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("listChanges",
+		mcp.WithDescription("List recent translation changes recorded in the project journal, newest first. Every translation written by translate, batchTranslate and revertChanges is recorded with the file, key, old and new value, time, session and client. Also summarizes the sessions that made the changes, to revert a whole session with revertChanges."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file or directory inside the project; only changes to files under it are listed"),
+		),
+		mcp.WithString("msgid",
+			mcp.Description("Only list the changes of this msgid"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The msgctxt of msgid"),
+		),
+		mcp.WithString("session",
+			mcp.Description("Only list the changes of this session"),
+		),
+		mcp.WithString("since",
+			mcp.Description("Only list the changes made at or after this time, in RFC 3339 format such as 2024-05-01T12:00:00Z"),
+		),
+		mcp.WithString("limit",
+			mcp.Description("Maximum number of changes to return (default: 50)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+
+		limit, err := strconv.Atoi(request.GetString("limit", "50"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid limit value: %v", err)), nil
+		}
+
+		filter := service.JournalFilter{
+			Path:    path,
+			MsgID:   request.GetString("msgid", ""),
+			Context: request.GetString("context", ""),
+			Session: request.GetString("session", ""),
+		}
+		if since := request.GetString("since", ""); since != "" {
+			if filter.Since, err = time.Parse(time.RFC3339, since); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid since value: %v", err)), nil
+			}
+		}
+
+		projectRoot := utils.FindProjectRoot(path)
+		journal, err := service.OpenJournal(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error opening journal: %v", err)), nil
+		}
+		entries, err := journal.Entries(filter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error reading journal: %v", err)), nil
+		}
+
+		changes := slices.Clone(entries)
+		slices.Reverse(changes)
+		if limit > 0 && len(changes) > limit {
+			changes = changes[:limit]
+		}
+
+		result := map[string]any{
+			"project_root": projectRoot,
+			"total_count":  len(entries),
+			"changes":      changes,
+			"sessions":     service.JournalSessions(entries),
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
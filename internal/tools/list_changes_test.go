@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListChangesTool(t *testing.T) {
+	// Create temporary project with a .git marker
+	tempDir, err := os.MkdirTemp("", "po_list_changes_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+	poFile := filepath.Join(tempDir, "locales", "de.po")
+	require.NoError(t, os.MkdirAll(filepath.Dir(poFile), 0755))
+	require.NoError(t, os.WriteFile(poFile, []byte("msgid \"Save\"\nmsgstr \"\"\n\nmsgid \"Open\"\nmsgstr \"Offen\"\n"), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewListChangesTool()
+
+	// Verify tool properties
+	assert.Equal(t, "listChanges", tool.Name)
+	assert.Contains(t, tool.Description, "journal")
+
+	listChanges := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("Empty Journal", func(t *testing.T) {
+		resultData := listChanges(t, map[string]interface{}{"path": tempDir})
+		assert.Equal(t, float64(0), resultData["total_count"])
+		assert.Empty(t, resultData["changes"])
+	})
+
+	// Translate twice through the translate tool
+	_, translate := NewTranslateTool()
+	for _, translations := range []string{`{"Save": "Speichern", "Open": "Öffnen"}`, `{"Save": "Sichern", "Open": "Öffnen"}`} {
+		result, err := translate(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": translations,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+	}
+
+	t.Run("List Changes", func(t *testing.T) {
+		resultData := listChanges(t, map[string]interface{}{"path": tempDir})
+
+		// The unchanged Open of the second call is not recorded
+		assert.Equal(t, float64(3), resultData["total_count"])
+		changes := resultData["changes"].([]interface{})
+		require.Len(t, changes, 3)
+		latest := changes[0].(map[string]interface{})
+		assert.Equal(t, "Save", latest["msgid"])
+		assert.Equal(t, []interface{}{"Speichern"}, latest["old_value"])
+		assert.Equal(t, []interface{}{"Sichern"}, latest["new_value"])
+		assert.Equal(t, "translate", latest["tool"])
+		assert.Equal(t, poFile, latest["file"])
+		assert.NotEmpty(t, latest["id"])
+		assert.NotEmpty(t, latest["time"])
+
+		sessions := resultData["sessions"].([]interface{})
+		require.Len(t, sessions, 1)
+		assert.Equal(t, float64(3), sessions[0].(map[string]interface{})["count"])
+	})
+
+	t.Run("Filter By Key", func(t *testing.T) {
+		resultData := listChanges(t, map[string]interface{}{"path": poFile, "msgid": "Open"})
+		assert.Equal(t, float64(1), resultData["total_count"])
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		resultData := listChanges(t, map[string]interface{}{"path": tempDir, "limit": "1"})
+		assert.Equal(t, float64(3), resultData["total_count"])
+		assert.Len(t, resultData["changes"], 1)
+	})
+
+	t.Run("Invalid Since", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":  tempDir,
+			"since": "yesterday",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid since value")
+	})
+
+	t.Run("Missing Path", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		assert.Error(t, err)
+	})
+}
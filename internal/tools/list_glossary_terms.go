@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewListGlossaryTermsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("listGlossaryTerms",
+		mcp.WithDescription("List the terms of the project glossary, optionally only those that apply to one language or occur in a text."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file or directory inside the project"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Only list terms that apply to this language, including do-not-translate terms"),
+		),
+		mcp.WithString("text",
+			mcp.Description("Only list terms that occur in this source text"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+		language := request.GetString("language", "")
+		text := request.GetString("text", "")
+
+		projectRoot := utils.FindProjectRoot(path)
+		glossary, err := service.LoadGlossary(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading glossary: %v", err)), nil
+		}
+
+		terms := glossary.ForLanguage(language)
+		if text != "" {
+			terms = glossary.Matches(language, text)
+		}
+
+		result := map[string]any{
+			"project_root": projectRoot,
+			"count":        len(terms),
+			"terms":        terms,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
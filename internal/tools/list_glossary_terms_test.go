@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGlossary = `{
+  "terms": [
+    { "term": "Acme", "do_not_translate": true },
+    { "term": "Seat", "language": "de", "translation": "Lizenz" },
+    { "term": "Workspace", "language": "de", "translation": "Arbeitsbereich" },
+    { "term": "Workspace", "language": "fr", "translation": "espace de travail" }
+  ]
+}
+`
+
+// writeTestGlossary creates a project with a glossary and returns its root
+func writeTestGlossary(t *testing.T, prefix string) string {
+	tempDir, err := os.MkdirTemp("", prefix)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, ".i18n-mcp"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, ".i18n-mcp", "glossary.json"), []byte(testGlossary), 0644))
+	return tempDir
+}
+
+func TestListGlossaryTermsTool(t *testing.T) {
+	tempDir := writeTestGlossary(t, "po_glossary_list_test")
+	defer os.RemoveAll(tempDir)
+
+	// Get the tool and handler
+	tool, handler := NewListGlossaryTermsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "listGlossaryTerms", tool.Name)
+	assert.Contains(t, tool.Description, "glossary")
+
+	list := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("All Terms", func(t *testing.T) {
+		resultData := list(t, map[string]interface{}{"path": tempDir})
+		assert.Equal(t, float64(4), resultData["count"])
+	})
+
+	t.Run("By Language", func(t *testing.T) {
+		resultData := list(t, map[string]interface{}{"path": tempDir, "language": "fr_CA"})
+		assert.Equal(t, float64(2), resultData["count"])
+	})
+
+	t.Run("By Text", func(t *testing.T) {
+		resultData := list(t, map[string]interface{}{"path": tempDir, "language": "de", "text": "Add seats to your workspace"})
+		assert.Equal(t, float64(2), resultData["count"])
+		terms := resultData["terms"].([]interface{})
+		assert.Equal(t, "Lizenz", terms[0].(map[string]interface{})["translation"])
+	})
+
+	t.Run("Invalid Glossary", func(t *testing.T) {
+		brokenDir := writeTestGlossary(t, "po_glossary_broken_test")
+		defer os.RemoveAll(brokenDir)
+		require.NoError(t, os.WriteFile(filepath.Join(brokenDir, ".i18n-mcp", "glossary.json"), []byte("{"), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{"path": brokenDir}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error loading glossary")
+	})
+
+	t.Run("Missing Path Parameter", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "path parameter is required")
+	})
+}
@@ -0,0 +1,108 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// reviewFile lists the entries of one catalog in the requested review states
+type reviewFile struct {
+	File     string               `json:"file"`
+	Language string               `json:"language"`
+	Entries  []service.ReviewItem `json:"entries"`
+}
+
+func NewListPendingReviewsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("listPendingReviews",
+		mcp.WithDescription("List translations awaiting review in a PO file or in every PO file of a directory. translate marks the translations it writes as draft; approve or reject them with reviewTranslations. Review states are stored as \"review:<state>\" flags on the entries."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file, or a directory to scan for .po files"),
+		),
+		mcp.WithString("states",
+			mcp.Description("Comma-separated review states to list: draft, reviewed, approved, rejected (default: draft)"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Only list the files of this language when scanning a directory"),
+		),
+		mcp.WithString("limit",
+			mcp.Description("Maximum number of entries to return (default: 100)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+
+		states := splitList(request.GetString("states", service.ReviewDraft))
+		for _, state := range states {
+			if !service.IsReviewState(state) {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid states value: %s", state)), nil
+			}
+		}
+
+		limit, err := strconv.Atoi(request.GetString("limit", "100"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid limit value: %v", err)), nil
+		}
+		language := request.GetString("language", "")
+
+		var catalogFiles []service.CatalogFile
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			catalogFiles, _, err = service.ReadCatalogFiles(path)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+			}
+		} else {
+			catalog, err := utils.ReadCatalog(path)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+			}
+			info := utils.NewPoFileInfo(path, catalog.Language())
+			catalogFiles = []service.CatalogFile{{Path: path, Language: info.Language, Catalog: catalog}}
+		}
+
+		files := []reviewFile{}
+		totalCount, returned := 0, 0
+		for _, file := range catalogFiles {
+			if language != "" && !utils.LanguagesMatch(language, file.Language) {
+				continue
+			}
+			items := service.ListReview(file.Catalog, states)
+			totalCount += len(items)
+			if limit > 0 {
+				items = items[:min(len(items), max(limit-returned, 0))]
+			}
+			returned += len(items)
+			if len(items) > 0 {
+				files = append(files, reviewFile{File: file.Path, Language: file.Language, Entries: items})
+			}
+		}
+
+		result := map[string]any{
+			"path":        path,
+			"states":      states,
+			"total_count": totalCount,
+			"files":       files,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPendingReviewsTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_pending_reviews_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	deFile := filepath.Join(tempDir, "de.po")
+	require.NoError(t, os.WriteFile(deFile, []byte("msgid \"Save\"\nmsgstr \"\"\n\nmsgid \"Open\"\nmsgstr \"\"\n"), 0644))
+	frFile := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(frFile, []byte("#, review:approved\nmsgid \"Save\"\nmsgstr \"Enregistrer\"\n"), 0644))
+
+	// Translations written by translate are drafts
+	_, translate := NewTranslateTool()
+	result, err := translate(context.Background(), makeRequest(map[string]interface{}{
+		"file_path":    deFile,
+		"translations": `{"Save": "Speichern"}`,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	result, err = translate(context.Background(), makeRequest(map[string]interface{}{
+		"file_path":    deFile,
+		"translations": `{"Open": "Öffnen"}`,
+		"review_state": "none",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// Get the tool and handler
+	tool, handler := NewListPendingReviewsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "listPendingReviews", tool.Name)
+	assert.Contains(t, tool.Description, "awaiting review")
+
+	listReviews := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("Drafts In Directory", func(t *testing.T) {
+		resultData := listReviews(t, map[string]interface{}{"path": tempDir})
+		assert.Equal(t, float64(1), resultData["total_count"])
+		files := resultData["files"].([]interface{})
+		require.Len(t, files, 1)
+		file := files[0].(map[string]interface{})
+		assert.Equal(t, deFile, file["file"])
+		entry := file["entries"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "Save", entry["msgid"])
+		assert.Equal(t, "draft", entry["state"])
+	})
+
+	t.Run("States Of One File", func(t *testing.T) {
+		resultData := listReviews(t, map[string]interface{}{"path": frFile, "states": "draft,approved"})
+		assert.Equal(t, float64(1), resultData["total_count"])
+	})
+
+	t.Run("Invalid State", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"path":   tempDir,
+			"states": "pending",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid states value")
+	})
+}
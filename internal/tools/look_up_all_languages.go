@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewLookUpAllLanguagesTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("lookUpAllLanguages",
+		mcp.WithDescription("Show how a msgid is translated in every language at once. Scans a directory for .po files and returns the msgstr, flags, file and recorded provenance of the msgid for each language, so already translated sibling languages (such as es for pt) can be used as a reference. Languages whose files lack the msgid are listed in missing_languages."),
+		mcp.WithString("directory",
+			mcp.Required(),
+			mcp.Description("The directory path to scan for .po files"),
+		),
+		mcp.WithString("msgid",
+			mcp.Required(),
+			mcp.Description("The msgid to look up"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The msgctxt of the msgid"),
+		),
+		mcp.WithString("languages",
+			mcp.Description("Comma-separated languages to include (default: all languages found)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		directory, err := request.RequireString("directory")
+		if err != nil {
+			return nil, fmt.Errorf("directory parameter is required: %w", err)
+		}
+
+		msgid, err := request.RequireString("msgid")
+		if err != nil {
+			return nil, fmt.Errorf("msgid parameter is required: %w", err)
+		}
+		msgctxt := request.GetString("context", "")
+		languages := splitList(request.GetString("languages", ""))
+
+		catalogFiles, skipped, err := service.ReadCatalogFiles(directory)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+		}
+
+		// Keep the files of the requested languages; "pt" selects pt-BR and pt-PT
+		files := []service.CatalogFile{}
+		for _, file := range catalogFiles {
+			if len(languages) == 0 || languageSelected(languages, file.Language) {
+				files = append(files, file)
+			}
+		}
+
+		translations := service.MessageTranslations(files, msgctxt, msgid)
+
+		found := make(map[string]bool)
+		for _, translation := range translations {
+			found[translation.Language] = true
+		}
+		missing := []string{}
+		for _, file := range files {
+			if !found[file.Language] {
+				found[file.Language] = true
+				missing = append(missing, file.Language)
+			}
+		}
+		sort.Strings(missing)
+
+		result := map[string]any{
+			"directory":         directory,
+			"msgid":             msgid,
+			"context":           msgctxt,
+			"translations":      translations,
+			"missing_languages": missing,
+			"skipped_files":     skipped,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+// languageSelected reports whether a file language matches one of the requested languages
+func languageSelected(languages []string, language string) bool {
+	for _, selected := range languages {
+		if utils.LanguagesMatch(selected, language) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookUpAllLanguagesTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_all_languages_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"es/LC_MESSAGES/app.po": "msgid \"Save changes\"\nmsgstr \"Guardar cambios\"\n",
+		"pt_BR.po":              "msgid \"\"\nmsgstr \"\"\n\"Language: pt_BR\\n\"\n\nmsgid \"Save changes\"\nmsgstr \"\"\n",
+		"pt_PT.po":              "msgid \"\"\nmsgstr \"\"\n\"Language: pt_PT\\n\"\n\nmsgid \"Save changes\"\nmsgstr \"Guardar alterações\"\n",
+		"de.po":                 "msgid \"Cancel\"\nmsgstr \"Abbrechen\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	// Get the tool and handler
+	tool, handler := NewLookUpAllLanguagesTool()
+
+	// Verify tool properties
+	assert.Equal(t, "lookUpAllLanguages", tool.Name)
+	assert.Contains(t, tool.Description, "every language")
+
+	t.Run("All Languages", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Save changes",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		translations := resultData["translations"].([]interface{})
+		require.Len(t, translations, 3)
+		es := translations[0].(map[string]interface{})
+		assert.Equal(t, "es", es["language"])
+		assert.Equal(t, []interface{}{"Guardar cambios"}, es["msgstr"])
+		assert.Equal(t, filepath.Join(tempDir, "es", "LC_MESSAGES", "app.po"), es["file"])
+		ptBR := translations[1].(map[string]interface{})
+		assert.Equal(t, "pt-BR", ptBR["language"])
+		assert.Equal(t, false, ptBR["translated"])
+
+		assert.Equal(t, []interface{}{"de"}, resultData["missing_languages"])
+	})
+
+	t.Run("Selected Languages", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Save changes",
+			"languages": "pt,de",
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		translations := resultData["translations"].([]interface{})
+		require.Len(t, translations, 2)
+		assert.Equal(t, "pt-PT", translations[1].(map[string]interface{})["language"])
+		assert.Equal(t, []interface{}{"de"}, resultData["missing_languages"])
+	})
+
+	t.Run("Missing Parameters", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{"msgid": "Save changes"}))
+		assert.ErrorContains(t, err, "directory parameter is required")
+
+		_, err = handler(context.Background(), makeRequest(map[string]interface{}{"directory": tempDir}))
+		assert.ErrorContains(t, err, "msgid parameter is required")
+	})
+}
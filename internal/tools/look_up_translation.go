@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -15,7 +14,7 @@ import (
 
 func NewLookUpTranslationTool() (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("lookUpTranslation",
-		mcp.WithDescription("Search for a term key and return the translated value from a PO file. Use this tool to look up the previous translation of a term."),
+		mcp.WithDescription("Search for a term key and return the translated value from a PO file. Use this tool to look up the previous translation of a term. Besides the msgid, the translations, contexts, comments and references can be searched by substring, exact match, regular expression, whole word or typo-tolerant fuzzy matching. Each match lists the fields that matched and, when recorded, the provenance of its translation."),
 		mcp.WithString("file_path",
 			mcp.Required(),
 			mcp.Description("The path to the .po file"),
@@ -24,6 +23,18 @@ func NewLookUpTranslationTool() (mcp.Tool, server.ToolHandlerFunc) {
 			mcp.Required(),
 			mcp.Description("The term key to search for"),
 		),
+		mcp.WithString("mode",
+			mcp.Description("How to match: 'substring' (default), 'exact', 'regex', 'word' for whole words or 'fuzzy' for typo-tolerant matching with scores"),
+		),
+		mcp.WithString("fields",
+			mcp.Description("Comma-separated fields to search: msgid, msgstr, context, comments, references (default: msgid)"),
+		),
+		mcp.WithString("case_sensitive",
+			mcp.Description("Set to 'true' for case-sensitive matching (default: false)"),
+		),
+		mcp.WithString("min_score",
+			mcp.Description("Lowest similarity between 0 and 1 matched in fuzzy mode (default: 0.7)"),
+		),
 		mcp.WithString("page_size",
 			mcp.Description("Number of results to return per page (default: 10)"),
 		),
@@ -56,54 +67,59 @@ func NewLookUpTranslationTool() (mcp.Tool, server.ToolHandlerFunc) {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid page value: %v", err)), nil
 		}
 
-		// Parse the PO file
-		po, err := utils.ParsePoFile(filePath)
+		caseSensitive, err := strconv.ParseBool(request.GetString("case_sensitive", "false"))
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid case_sensitive value: %v", err)), nil
 		}
 
-		// Create PoService instance
-		poService := service.NewPoService(po)
-
-		// Get all translations for searching
-		// Using a large number to get all translations for filtering
-		allTranslations := poService.List(0, 100000)
+		options := service.SearchOptions{
+			Mode:          request.GetString("mode", service.SearchSubstring),
+			Fields:        splitList(request.GetString("fields", "msgid")),
+			CaseSensitive: caseSensitive,
+		}
 
-		// Filter translations that contain the search term
-		matchingTranslations := make(map[string]string)
-		for msgid, msgstr := range allTranslations {
-			if strings.Contains(strings.ToLower(msgid), strings.ToLower(searchTerm)) {
-				matchingTranslations[msgid] = msgstr
+		// Only an absent min_score falls back to the default, 0 matches everything
+		if minScoreStr := request.GetString("min_score", ""); minScoreStr != "" {
+			minScore, err := strconv.ParseFloat(minScoreStr, 64)
+			if err != nil || minScore < 0 || minScore > 1 {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid min_score value: %s", minScoreStr)), nil
 			}
+			options.MinScore = &minScore
+		}
+
+		// Parse the PO file
+		catalog, err := utils.ReadCatalog(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		matches, err := service.SearchCatalog(catalog, searchTerm, options)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid search: %v", err)), nil
 		}
 
 		// Apply pagination to matching results
-		skip := (page - 1) * pageSize
+		skip := min(max((page-1)*pageSize, 0), len(matches))
+		pageMatches := matches[skip:min(skip+max(pageSize, 0), len(matches))]
 		paginatedResults := make(map[string]string)
-		count := 0
-		taken := 0
-
-		for msgid, msgstr := range matchingTranslations {
-			if count < skip {
-				count++
-				continue
-			}
-			if taken >= pageSize {
-				break
+		for _, match := range pageMatches {
+			paginatedResults[match.MsgID] = ""
+			if len(match.MsgStr) > 0 {
+				paginatedResults[match.MsgID] = match.MsgStr[0]
 			}
-			paginatedResults[msgid] = msgstr
-			taken++
-			count++
 		}
 
 		// Create result object
 		result := map[string]interface{}{
 			"file_path":     filePath,
 			"search_term":   searchTerm,
+			"mode":          options.Mode,
+			"fields":        options.Fields,
 			"page":          page,
 			"page_size":     pageSize,
-			"total_matches": len(matchingTranslations),
+			"total_matches": len(matches),
 			"translations":  paginatedResults,
+			"matches":       pageMatches,
 		}
 
 		resultJSON, err := json.MarshalIndent(result, "", "  ")
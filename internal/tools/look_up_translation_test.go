@@ -176,6 +176,83 @@ msgstr "botón cancelar"
 		assert.Len(t, translations, 1)
 	})
 
+	t.Run("Reverse Lookup In Msgstr", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":   poFile,
+			"search_term": "botón",
+			"fields":      "msgid,msgstr",
+			"mode":        "word",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(2), resultData["total_matches"])
+		assert.Equal(t, "word", resultData["mode"])
+		matches := resultData["matches"].([]interface{})
+		require.Len(t, matches, 2)
+		first := matches[0].(map[string]interface{})
+		assert.Equal(t, "button_ok", first["msgid"])
+		assert.Equal(t, []interface{}{"msgstr"}, first["matched_fields"])
+	})
+
+	t.Run("Exact Match", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":   poFile,
+			"search_term": "hello",
+			"mode":        "exact",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(1), resultData["total_matches"])
+		assert.Equal(t, map[string]interface{}{"hello": "hola"}, resultData["translations"])
+	})
+
+	t.Run("Fuzzy Match", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":   poFile,
+			"search_term": "welcom_mesage",
+			"mode":        "fuzzy",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		matches := resultData["matches"].([]interface{})
+		require.Len(t, matches, 1)
+		match := matches[0].(map[string]interface{})
+		assert.Equal(t, "welcome_message", match["msgid"])
+		assert.Greater(t, match["score"], 0.8)
+	})
+
+	t.Run("Invalid Search Options", func(t *testing.T) {
+		for name, params := range map[string]map[string]interface{}{
+			"mode":      {"file_path": poFile, "search_term": "hello", "mode": "soundex"},
+			"fields":    {"file_path": poFile, "search_term": "hello", "fields": "flags"},
+			"regex":     {"file_path": poFile, "search_term": "(", "mode": "regex"},
+			"min_score": {"file_path": poFile, "search_term": "hello", "min_score": "high"},
+		} {
+			result, err := handler(context.Background(), makeRequest(params))
+			require.NoError(t, err, name)
+			assert.True(t, result.IsError, name)
+		}
+	})
+
 	// Test with non-existent file
 	t.Run("Non-existent File", func(t *testing.T) {
 		request := makeRequest(map[string]interface{}{
@@ -0,0 +1,20 @@
+package tools
+
+import (
+	"os"
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// TestMain keeps the journals and catalog indexes written by the tests out of the user config directory
+func TestMain(m *testing.M) {
+	dataDir, err := os.MkdirTemp("", "i18n_mcp_data")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv(utils.DataDirEnv, dataDir)
+	code := m.Run()
+	os.RemoveAll(dataDir)
+	os.Exit(code)
+}
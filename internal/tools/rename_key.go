@@ -0,0 +1,205 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewRenameKeyTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("renameKey",
+		mcp.WithDescription("Rename a msgid (and msgctxt) in every PO file of a directory, carrying the existing translations over to the new msgid. Translations carried over are marked fuzzy with the old msgid kept as the previous msgid, so they are checked against the reworded source. Where the new msgid already exists untranslated it takes over the translation; where it is already translated the file is reported as a conflict and left alone. Changes are recorded in the journal and can be undone with revertChanges."),
+		mcp.WithString("directory",
+			mcp.Required(),
+			mcp.Description("The directory to scan for .po files"),
+		),
+		mcp.WithString("msgid",
+			mcp.Required(),
+			mcp.Description("The current msgid"),
+		),
+		mcp.WithString("new_msgid",
+			mcp.Required(),
+			mcp.Description("The new msgid"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The current msgctxt of the key"),
+		),
+		mcp.WithString("new_context",
+			mcp.Description("The new msgctxt of the key (default: the current context)"),
+		),
+		mcp.WithString("mark_fuzzy",
+			mcp.Description("Set to \"false\" to keep carried over translations as they are instead of marking them fuzzy (default: true)"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to report the affected files with a unified diff instead of writing them (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		directory, err := request.RequireString("directory")
+		if err != nil {
+			return nil, fmt.Errorf("directory parameter is required: %w", err)
+		}
+
+		msgid, err := request.RequireString("msgid")
+		if err != nil {
+			return nil, fmt.Errorf("msgid parameter is required: %w", err)
+		}
+
+		newMsgID, err := request.RequireString("new_msgid")
+		if err != nil {
+			return nil, fmt.Errorf("new_msgid parameter is required: %w", err)
+		}
+
+		markFuzzy, err := strconv.ParseBool(request.GetString("mark_fuzzy", "true"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid mark_fuzzy value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		msgctxt := request.GetString("context", "")
+		newContext := request.GetString("new_context", msgctxt)
+		if newMsgID == "" {
+			return mcp.NewToolResultError("new_msgid cannot be empty"), nil
+		}
+		if utils.EntryKey(msgctxt, msgid) == utils.EntryKey(newContext, newMsgID) {
+			return mcp.NewToolResultError("The new key is the same as the current key"), nil
+		}
+
+		catalogFiles, skipped, err := parseDirectoryCatalogs(directory)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error scanning for PO files: %v", err)), nil
+		}
+
+		changes := []service.KeyChange{}
+		counts := make(map[string]int)
+		for _, file := range catalogFiles {
+			change := service.RenameKey(file.Catalog, file.Path, msgctxt, msgid, newContext, newMsgID, markFuzzy)
+			change.Language = file.Language
+			counts[change.Status]++
+			if change.Status != service.KeyMissing {
+				changes = append(changes, change)
+			}
+		}
+
+		diff, writeErrors := saveKeyChanges(ctx, tool.Name, catalogFiles, changes, dryRun)
+
+		renamed := counts[service.KeyRenamed] + counts[service.KeyMerged]
+		message := fmt.Sprintf("Renamed the key in %d files", renamed)
+		if dryRun {
+			message = fmt.Sprintf("Dry run: the key would be renamed in %d files, nothing was written", renamed)
+		}
+		if counts[service.KeyConflict] > 0 {
+			message += fmt.Sprintf(". %d files already translate the new msgid and were left alone", counts[service.KeyConflict])
+		}
+		if writeErrors > 0 {
+			message += fmt.Sprintf(". %d files could not be written", writeErrors)
+		}
+
+		result := map[string]any{
+			"directory":      directory,
+			"context":        msgctxt,
+			"msgid":          msgid,
+			"new_context":    newContext,
+			"new_msgid":      newMsgID,
+			"renamed_count":  renamed,
+			"conflict_count": counts[service.KeyConflict],
+			"missing_count":  counts[service.KeyMissing],
+			"files":          changes,
+			"message":        message,
+		}
+		if len(skipped) > 0 {
+			result["skipped_files"] = skipped
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+// parseDirectoryCatalogs parses every .po file of a directory for writing. Files that
+// cannot be parsed are returned as skipped.
+func parseDirectoryCatalogs(directory string) ([]service.CatalogFile, []string, error) {
+	poFilesInfo, err := utils.ScanPoFilesWithInfo(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+	files := []service.CatalogFile{}
+	skipped := []string{}
+	for _, info := range poFilesInfo {
+		catalog, err := utils.ParseCatalogFile(info.Path)
+		if err != nil {
+			skipped = append(skipped, info.Path)
+			continue
+		}
+		files = append(files, service.CatalogFile{Path: info.Path, Language: info.Language, Catalog: catalog})
+	}
+	return files, skipped, nil
+}
+
+// saveKeyChanges writes the catalogs modified by changes and records each file in the
+// journal right after it was written, so the files written before a failure can still
+// be reverted. Write and journal errors are reported on the change of the file. It
+// returns the joined diff of a dry run and the number of files that could not be written.
+func saveKeyChanges(ctx context.Context, toolName string, files []service.CatalogFile, changes []service.KeyChange, dryRun bool) (string, int) {
+	catalogs := make(map[string]*utils.Catalog, len(files))
+	for _, file := range files {
+		catalogs[file.Path] = file.Catalog
+	}
+	session, client := journalSession(ctx)
+	var diffs []string
+	writeErrors := 0
+	for i := range changes {
+		change := &changes[i]
+		if !change.Changed() {
+			continue
+		}
+		diff, err := saveCatalog(catalogs[change.File], change.File, dryRun)
+		if err != nil {
+			change.Error = fmt.Sprintf("Error writing to PO file: %v", err)
+			writeErrors++
+			continue
+		}
+		diffs = append(diffs, diff)
+		if dryRun {
+			continue
+		}
+		if err := recordKeyChange(change.File, toolName, session, client, change.Journal); err != nil {
+			change.Error = fmt.Sprintf("Error recording changes in the journal: %v", err)
+		}
+	}
+	return strings.Join(diffs, ""), writeErrors
+}
+
+// recordKeyChange adds the renamed or deleted key of one file to the journal of its project
+func recordKeyChange(path, toolName, session, client string, entries []service.JournalEntry) error {
+	journal, err := service.OpenJournal(utils.FindProjectRoot(path))
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		entries[i].Tool, entries[i].Session, entries[i].Client = toolName, session, client
+	}
+	return journal.Append(entries)
+}
@@ -0,0 +1,200 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameKeyTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_rename_key_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+
+	deFile := filepath.Join(tempDir, "de.po")
+	frFile := filepath.Join(tempDir, "fr.po")
+	esFile := filepath.Join(tempDir, "es.po")
+	esContent := "msgid \"Open\"\nmsgstr \"Abrir\"\n"
+	frContent := "msgid \"Save\"\nmsgstr \"Enregistrer\"\n\nmsgid \"Save changes\"\nmsgstr \"Enregistrer les modifications\"\n"
+	require.NoError(t, os.WriteFile(deFile, []byte("msgid \"Save\"\nmsgstr \"Speichern\"\n"), 0644))
+	require.NoError(t, os.WriteFile(frFile, []byte(frContent), 0644))
+	require.NoError(t, os.WriteFile(esFile, []byte(esContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewRenameKeyTool()
+
+	// Verify tool properties
+	assert.Equal(t, "renameKey", tool.Name)
+	assert.Contains(t, tool.Description, "Rename a msgid")
+
+	renameKey := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		return resultData
+	}
+
+	t.Run("Dry Run", func(t *testing.T) {
+		resultData := renameKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Save",
+			"new_msgid": "Save changes",
+			"dry_run":   "true",
+		})
+		assert.Equal(t, float64(1), resultData["renamed_count"])
+		assert.Equal(t, float64(1), resultData["conflict_count"])
+		assert.Equal(t, float64(1), resultData["missing_count"])
+		assert.Contains(t, resultData["diff"], "-msgid \"Save\"\n+#, fuzzy\n+#| msgid \"Save\"\n+msgid \"Save changes\"\n")
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Equal(t, "msgid \"Save\"\nmsgstr \"Speichern\"\n", string(content))
+	})
+
+	t.Run("Rename Across Languages", func(t *testing.T) {
+		resultData := renameKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Save",
+			"new_msgid": "Save changes",
+		})
+		files := resultData["files"].([]interface{})
+		require.Len(t, files, 2)
+		statuses := map[string]string{}
+		for _, file := range files {
+			file := file.(map[string]interface{})
+			assert.NotContains(t, file, "error")
+			statuses[file["language"].(string)] = file["status"].(string)
+		}
+		assert.Equal(t, map[string]string{"de": "renamed", "fr": "conflict"}, statuses)
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Equal(t, "#, fuzzy\n#| msgid \"Save\"\nmsgid \"Save changes\"\nmsgstr \"Speichern\"\n", string(content))
+
+		// Files with a conflict or without the key are left alone
+		content, err = os.ReadFile(frFile)
+		require.NoError(t, err)
+		assert.Equal(t, frContent, string(content))
+		content, err = os.ReadFile(esFile)
+		require.NoError(t, err)
+		assert.Equal(t, esContent, string(content))
+	})
+
+	t.Run("Undo With Revert", func(t *testing.T) {
+		_, revertChanges := NewRevertChangesTool()
+		result, err := revertChanges(context.Background(), makeRequest(map[string]interface{}{
+			"path":  deFile,
+			"msgid": "Save changes",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+		result, err = revertChanges(context.Background(), makeRequest(map[string]interface{}{
+			"path":  deFile,
+			"msgid": "Save",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Equal(t, "msgid \"Save\"\nmsgstr \"Speichern\"\n", string(content))
+	})
+
+	t.Run("Rename Then Translate", func(t *testing.T) {
+		renameKey(t, map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Save",
+			"new_msgid": "Save file",
+		})
+
+		// The fuzzy entry carried over is listed for translation
+		_, getUntranslatedTerms := NewGetUntranslatedTermsTool()
+		result, err := getUntranslatedTerms(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": deFile,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+		var untranslated map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(getTextContent(t, result)), &untranslated))
+		assert.Equal(t, float64(1), untranslated["count"])
+		assert.Equal(t, map[string]interface{}{"Save file": "Speichern"}, untranslated["untranslated_terms"])
+
+		// Translating it clears the fuzzy flag and the previous msgid
+		_, translate := NewTranslateTool()
+		result, err = translate(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    deFile,
+			"translations": `{"Save file": "Datei speichern"}`,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+		assert.Contains(t, getTextContent(t, result), `"Save file": "new"`)
+
+		content, err := os.ReadFile(deFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "#, review:draft\nmsgid \"Save file\"\nmsgstr \"Datei speichern\"\n")
+		assert.NotContains(t, string(content), "fuzzy")
+		assert.NotContains(t, string(content), "#|")
+
+		result, err = getUntranslatedTerms(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": deFile,
+		}))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal([]byte(getTextContent(t, result)), &untranslated))
+		assert.Equal(t, float64(0), untranslated["count"])
+	})
+
+	t.Run("Same Key", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"directory": tempDir,
+			"msgid":     "Save",
+			"new_msgid": "Save",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "same as the current key")
+	})
+}
+
+func TestSaveKeyChanges(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_save_key_changes_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+
+	// The first file cannot be written since its directory does not exist
+	var files []service.CatalogFile
+	var changes []service.KeyChange
+	for _, path := range []string{filepath.Join(tempDir, "missing", "de.po"), filepath.Join(tempDir, "fr.po")} {
+		catalog, err := utils.ParseCatalog([]byte("msgid \"Save\"\nmsgstr \"Enregistrer\"\n"))
+		require.NoError(t, err)
+		files = append(files, service.CatalogFile{Path: path, Catalog: catalog})
+		changes = append(changes, service.DeleteKey(catalog, path, "", "Save"))
+	}
+
+	_, writeErrors := saveKeyChanges(context.Background(), "deleteKey", files, changes, false)
+	assert.Equal(t, 1, writeErrors)
+	assert.Contains(t, changes[0].Error, "Error writing to PO file")
+	assert.Empty(t, changes[1].Error)
+
+	// The file written after the failure is journaled and can be reverted
+	journal, err := service.OpenJournal(tempDir)
+	require.NoError(t, err)
+	entries, err := journal.Entries(service.JournalFilter{Path: tempDir})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, filepath.Join(tempDir, "fr.po"), entries[0].File)
+	assert.True(t, entries[0].Removed)
+}
@@ -0,0 +1,202 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewRevertChangesTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("revertChanges",
+		mcp.WithDescription("Revert translation changes recorded in the project journal (see listChanges): single changes by ID, every change of a key, or an entire session. Each key is restored to its value before the earliest reverted change. Keys changed again after the reverted changes are reported as conflicts and left alone unless force is set. Reverts are recorded in the journal too."),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("A .po file or directory inside the project; only changes to files under it are reverted"),
+		),
+		mcp.WithString("change_id",
+			mcp.Description("Comma-separated IDs of the changes to revert"),
+		),
+		mcp.WithString("msgid",
+			mcp.Description("Revert every change of this msgid, combined with session to only revert the changes of one session"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The msgctxt of msgid"),
+		),
+		mcp.WithString("session",
+			mcp.Description("Revert every change made in this session"),
+		),
+		mcp.WithString("force",
+			mcp.Description("Set to \"true\" to also revert keys changed again after the reverted changes (default: false)"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the revert instead of writing the files (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		path, err := request.RequireString("path")
+		if err != nil {
+			return nil, fmt.Errorf("path parameter is required: %w", err)
+		}
+
+		force, err := strconv.ParseBool(request.GetString("force", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid force value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		changeIDs := splitList(request.GetString("change_id", ""))
+		filter := service.JournalFilter{
+			Path:    path,
+			MsgID:   request.GetString("msgid", ""),
+			Context: request.GetString("context", ""),
+			Session: request.GetString("session", ""),
+		}
+		switch {
+		case len(changeIDs) == 0 && filter.MsgID == "" && filter.Session == "":
+			return mcp.NewToolResultError("One of change_id, msgid or session is required"), nil
+		case len(changeIDs) > 0 && (filter.MsgID != "" || filter.Session != ""):
+			return mcp.NewToolResultError("change_id cannot be combined with msgid or session"), nil
+		}
+
+		projectRoot := utils.FindProjectRoot(path)
+		journal, err := service.OpenJournal(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error opening journal: %v", err)), nil
+		}
+		entries, err := journal.Entries(filter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error reading journal: %v", err)), nil
+		}
+
+		changes := entries
+		if len(changeIDs) > 0 {
+			byID := make(map[string]service.JournalEntry, len(entries))
+			for _, entry := range entries {
+				byID[entry.ID] = entry
+			}
+			changes = []service.JournalEntry{}
+			for _, id := range changeIDs {
+				entry, ok := byID[id]
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("Change %q not found in the journal", id)), nil
+				}
+				changes = append(changes, entry)
+			}
+		}
+		if len(changes) == 0 {
+			return mcp.NewToolResultError("No changes found to revert"), nil
+		}
+
+		// Parse every file before changing anything
+		targets := service.PlanRevert(changes)
+		catalogs := make(map[string]*utils.Catalog)
+		files := []string{}
+		for _, target := range targets {
+			if _, ok := catalogs[target.File]; ok {
+				continue
+			}
+			catalog, err := utils.ParseCatalogFile(target.File)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+			}
+			catalogs[target.File] = catalog
+			files = append(files, target.File)
+		}
+
+		session, client := journalSession(ctx)
+		revertsByFile := make(map[string][]service.JournalEntry)
+		targetsByFile := make(map[string][]*service.RevertTarget)
+		for _, target := range targets {
+			if change := service.ApplyRevert(catalogs[target.File], target, force); change != nil {
+				change.Tool, change.Session, change.Client = tool.Name, session, client
+				revertsByFile[target.File] = append(revertsByFile[target.File], *change)
+				targetsByFile[target.File] = append(targetsByFile[target.File], target)
+			}
+		}
+
+		diff, unwritten := saveReverts(journal, files, catalogs, targetsByFile, revertsByFile, dryRun)
+		counts := make(map[string]int)
+		for _, target := range targets {
+			if target.Status == service.RevertReverted && unwritten[target.File] {
+				continue
+			}
+			counts[target.Status]++
+		}
+
+		message := fmt.Sprintf("Reverted %d keys", counts[service.RevertReverted])
+		if dryRun {
+			message = fmt.Sprintf("Dry run: %d keys would be reverted, nothing was written", counts[service.RevertReverted])
+		}
+		if counts[service.RevertConflict] > 0 {
+			message += fmt.Sprintf(". %d keys were changed again since and were left alone, retry with force to revert them anyway", counts[service.RevertConflict])
+		}
+		if len(unwritten) > 0 {
+			message += fmt.Sprintf(". %d files could not be written", len(unwritten))
+		}
+
+		result := map[string]any{
+			"project_root":           projectRoot,
+			"reverted_count":         counts[service.RevertReverted],
+			"conflict_count":         counts[service.RevertConflict],
+			"already_reverted_count": counts[service.RevertAlreadyReverted],
+			"keys":                   targets,
+			"message":                message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+// saveReverts writes the reverted catalogs and records their reverts in the journal.
+// A file that cannot be written is reported on its keys and the other files are still
+// saved. It returns the diff of the files and the files that could not be written.
+func saveReverts(journal *service.Journal, files []string, catalogs map[string]*utils.Catalog, targetsByFile map[string][]*service.RevertTarget, revertsByFile map[string][]service.JournalEntry, dryRun bool) (string, map[string]bool) {
+	var diffs []string
+	unwritten := make(map[string]bool)
+	for _, file := range files {
+		if len(revertsByFile[file]) == 0 {
+			continue
+		}
+		diff, err := saveCatalog(catalogs[file], file, dryRun)
+		if err != nil {
+			for _, target := range targetsByFile[file] {
+				target.Error = fmt.Sprintf("Error writing to PO file: %v", err)
+			}
+			unwritten[file] = true
+			continue
+		}
+		diffs = append(diffs, diff)
+		if dryRun {
+			continue
+		}
+		if err := journal.Append(revertsByFile[file]); err != nil {
+			for _, target := range targetsByFile[file] {
+				target.Error = fmt.Sprintf("Error recording changes in the journal: %v", err)
+			}
+		}
+	}
+	return strings.Join(diffs, ""), unwritten
+}
@@ -0,0 +1,172 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertChangesTool(t *testing.T) {
+	// Create temporary project with a .git marker
+	tempDir, err := os.MkdirTemp("", "po_revert_changes_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+	deFile := filepath.Join(tempDir, "de.po")
+	frFile := filepath.Join(tempDir, "fr.po")
+	deContent := "msgid \"Save\"\nmsgstr \"Speichern\"\n\nmsgid \"Open\"\nmsgstr \"\"\n"
+	frContent := "msgid \"Save\"\nmsgstr \"\"\n"
+	require.NoError(t, os.WriteFile(deFile, []byte(deContent), 0644))
+	require.NoError(t, os.WriteFile(frFile, []byte(frContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewRevertChangesTool()
+
+	// Verify tool properties
+	assert.Equal(t, "revertChanges", tool.Name)
+	assert.Contains(t, tool.Description, "journal")
+
+	// A session of bad translations
+	_, batchTranslate := NewBatchTranslateTool()
+	result, err := batchTranslate(context.Background(), makeRequest(map[string]interface{}{
+		"directory":    tempDir,
+		"translations": `{"de": {"Save": "Sichern", "Open": "Offen", "Close": "Schließen"}, "fr": {"Save": "Sauver"}}`,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	_, listChanges := NewListChangesTool()
+	listSession := func(t *testing.T) (string, []interface{}) {
+		result, err := listChanges(context.Background(), makeRequest(map[string]interface{}{"path": tempDir}))
+		require.NoError(t, err)
+		var resultData map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(getTextContent(t, result)), &resultData))
+		sessions := resultData["sessions"].([]interface{})
+		require.NotEmpty(t, sessions)
+		return sessions[0].(map[string]interface{})["session"].(string), resultData["changes"].([]interface{})
+	}
+	readFile := func(t *testing.T, path string) string {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(content)
+	}
+	revert := func(t *testing.T, args map[string]interface{}) map[string]interface{} {
+		result, err := handler(context.Background(), makeRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+		var resultData map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(getTextContent(t, result)), &resultData))
+		return resultData
+	}
+
+	t.Run("Revert Single Change", func(t *testing.T) {
+		_, changes := listSession(t)
+		var changeID string
+		for _, change := range changes {
+			change := change.(map[string]interface{})
+			if change["file"] == frFile {
+				changeID = change["id"].(string)
+			}
+		}
+		require.NotEmpty(t, changeID)
+
+		resultData := revert(t, map[string]interface{}{"path": tempDir, "change_id": changeID})
+		assert.Equal(t, float64(1), resultData["reverted_count"])
+		assert.Equal(t, frContent, readFile(t, frFile))
+	})
+
+	t.Run("Dry Run Session", func(t *testing.T) {
+		session, _ := listSession(t)
+		resultData := revert(t, map[string]interface{}{"path": deFile, "session": session, "dry_run": "true"})
+		assert.Equal(t, float64(3), resultData["reverted_count"])
+		assert.Contains(t, resultData["diff"], "-msgstr \"Sichern\"\n+msgstr \"Speichern\"\n")
+		assert.Contains(t, readFile(t, deFile), "Sichern")
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		// Open is changed again by hand
+		content := strings.Replace(readFile(t, deFile), "msgstr \"Offen\"", "msgstr \"Aufmachen\"", 1)
+		require.NoError(t, os.WriteFile(deFile, []byte(content), 0644))
+
+		resultData := revert(t, map[string]interface{}{"path": tempDir, "msgid": "Open"})
+		assert.Equal(t, float64(0), resultData["reverted_count"])
+		assert.Equal(t, float64(1), resultData["conflict_count"])
+		assert.Equal(t, content, readFile(t, deFile))
+	})
+
+	t.Run("Revert Session", func(t *testing.T) {
+		session, _ := listSession(t)
+		resultData := revert(t, map[string]interface{}{"path": tempDir, "session": session, "force": "true"})
+
+		// fr.po was reverted before
+		assert.Equal(t, float64(3), resultData["reverted_count"])
+		assert.Equal(t, float64(1), resultData["already_reverted_count"])
+		assert.Equal(t, deContent, readFile(t, deFile))
+		assert.Equal(t, frContent, readFile(t, frFile))
+
+		// The reverts are in the journal
+		_, changes := listSession(t)
+		assert.Equal(t, "revertChanges", changes[0].(map[string]interface{})["tool"])
+	})
+
+	t.Run("Missing Selection", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{"path": tempDir}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "One of change_id, msgid or session is required")
+	})
+
+	t.Run("Unknown Change", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{"path": tempDir, "change_id": "nope"}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "not found in the journal")
+	})
+}
+
+func TestSaveReverts(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_save_reverts_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, ".git"), 0755))
+	journal, err := service.OpenJournal(tempDir)
+	require.NoError(t, err)
+
+	// The first file cannot be written since its directory does not exist
+	files := []string{filepath.Join(tempDir, "missing", "de.po"), filepath.Join(tempDir, "fr.po")}
+	catalogs := make(map[string]*utils.Catalog)
+	targetsByFile := make(map[string][]*service.RevertTarget)
+	revertsByFile := make(map[string][]service.JournalEntry)
+	for _, file := range files {
+		catalog, err := utils.ParseCatalog([]byte("msgid \"Save\"\nmsgstr \"Sichern\"\n"))
+		require.NoError(t, err)
+		catalogs[file] = catalog
+		target := service.PlanRevert([]service.JournalEntry{{ID: "1", File: file, MsgID: "Save", OldValue: []string{"Speichern"}, NewValue: []string{"Sichern"}}})[0]
+		change := service.ApplyRevert(catalog, target, false)
+		require.NotNil(t, change)
+		targetsByFile[file] = []*service.RevertTarget{target}
+		revertsByFile[file] = []service.JournalEntry{*change}
+	}
+
+	_, unwritten := saveReverts(journal, files, catalogs, targetsByFile, revertsByFile, false)
+	assert.Equal(t, map[string]bool{files[0]: true}, unwritten)
+	assert.Contains(t, targetsByFile[files[0]][0].Error, "Error writing to PO file")
+	assert.Empty(t, targetsByFile[files[1]][0].Error)
+
+	// The file written after the failure is reverted and journaled
+	content, err := os.ReadFile(files[1])
+	require.NoError(t, err)
+	assert.Equal(t, "msgid \"Save\"\nmsgstr \"Speichern\"\n", string(content))
+	entries, err := journal.Entries(service.JournalFilter{Path: tempDir})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, files[1], entries[0].File)
+}
@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewReviewTranslationsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("reviewTranslations",
+		mcp.WithDescription("Approve or reject translations in a PO file, or mark them reviewed, with an optional reviewer comment stored as a translator comment. Rejected translations are also marked fuzzy so they are not used until fixed; approving clears the fuzzy flag."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file"),
+		),
+		mcp.WithString("msgids",
+			mcp.Required(),
+			mcp.Description("JSON array of the msgids to review, e.g. [\"Save\", \"Cancel\"]"),
+		),
+		mcp.WithString("state",
+			mcp.Description("The new review state: approved, reviewed, rejected or draft (default: approved)"),
+		),
+		mcp.WithString("reviewer",
+			mcp.Description("Name of the reviewer, recorded in the review comment"),
+		),
+		mcp.WithString("comment",
+			mcp.Description("Comment explaining the review, such as why a translation was rejected"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to return a unified diff of the file instead of writing it (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		msgidsStr, err := request.RequireString("msgids")
+		if err != nil {
+			return nil, fmt.Errorf("msgids parameter is required: %w", err)
+		}
+
+		var msgids []string
+		if err := json.Unmarshal([]byte(msgidsStr), &msgids); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid msgids JSON: %v", err)), nil
+		}
+
+		state := request.GetString("state", service.ReviewApproved)
+		if !service.IsReviewState(state) {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid state value: %s", state)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+		reviewer := request.GetString("reviewer", "")
+		comment := request.GetString("comment", "")
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		reviewed, notFound, untranslated := []string{}, []string{}, []string{}
+		for _, msgid := range msgids {
+			entry := catalog.Lookup("", msgid)
+			switch {
+			case entry == nil:
+				notFound = append(notFound, msgid)
+			case !entry.IsTranslated():
+				untranslated = append(untranslated, msgid)
+			default:
+				if err := service.SetReviewState(entry, state, reviewer, comment); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error setting review state: %v", err)), nil
+				}
+				reviewed = append(reviewed, msgid)
+			}
+		}
+
+		diff := ""
+		if len(reviewed) > 0 {
+			if diff, err = saveCatalog(catalog, filePath, dryRun); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
+			}
+		}
+
+		message := fmt.Sprintf("Marked %d translations as %s", len(reviewed), state)
+		if dryRun {
+			message = fmt.Sprintf("Dry run: %d translations would be marked as %s, nothing was written", len(reviewed), state)
+		}
+		if len(notFound)+len(untranslated) > 0 {
+			message += fmt.Sprintf(". Skipped %d msgids that are missing or untranslated", len(notFound)+len(untranslated))
+		}
+
+		result := map[string]any{
+			"file_path":      filePath,
+			"state":          state,
+			"reviewed_count": len(reviewed),
+			"reviewed":       reviewed,
+			"not_found":      notFound,
+			"untranslated":   untranslated,
+			"message":        message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewTranslationsTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_review_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	poContent := `#, review:draft
+msgid "Save"
+msgstr "Speichern"
+
+#, review:draft
+msgid "Open"
+msgstr "Offen"
+
+msgid "Close"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "de.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewReviewTranslationsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "reviewTranslations", tool.Name)
+	assert.Contains(t, tool.Description, "Approve or reject")
+
+	t.Run("Dry Run", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgids":    `["Save"]`,
+			"dry_run":   "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Contains(t, resultData["diff"], "-#, review:draft\n+#, review:approved\n")
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(content))
+	})
+
+	t.Run("Approve And Reject", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgids":    `["Save", "Close", "Missing"]`,
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), resultData["reviewed_count"])
+		assert.Equal(t, []interface{}{"Close"}, resultData["untranslated"])
+		assert.Equal(t, []interface{}{"Missing"}, resultData["not_found"])
+
+		result, err = handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgids":    `["Open"]`,
+			"state":     "rejected",
+			"reviewer":  "Anna",
+			"comment":   "Means not closed, use Öffnen",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "#, review:approved\nmsgid \"Save\"")
+		assert.Contains(t, string(content), "# review: rejected by Anna: Means not closed, use Öffnen\n#, review:rejected, fuzzy\nmsgid \"Open\"")
+	})
+
+	t.Run("Retranslate Rejected", func(t *testing.T) {
+		_, translate := NewTranslateTool()
+		result, err := translate(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": `{"Open": "Öffnen"}`,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextContent(t, result))
+
+		// The new translation is a draft again and no longer fuzzy
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "#, review:draft\nmsgid \"Open\"\nmsgstr \"Öffnen\"\n")
+		assert.NotContains(t, string(content), "fuzzy")
+	})
+
+	t.Run("Invalid State", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgids":    `["Save"]`,
+			"state":     "done",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid state value")
+	})
+
+	t.Run("Missing Msgids", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		}))
+		assert.Error(t, err)
+	})
+}
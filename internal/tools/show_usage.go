@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewShowUsageTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("showUsage",
+		mcp.WithDescription("Show the source code around each place a term is used. Use this tool to understand an ambiguous term before translating it."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file"),
+		),
+		mcp.WithString("msgid",
+			mcp.Required(),
+			mcp.Description("The term key to show the usages of"),
+		),
+		mcp.WithString("context",
+			mcp.Description("The msgctxt of the term, if any"),
+		),
+		mcp.WithString("project_root",
+			mcp.Description("The directory references are relative to (default: nearest parent directory with .git, package.json or go.mod)"),
+		),
+		mcp.WithString("context_lines",
+			mcp.Description("Number of source lines to show before and after each usage (default: 5)"),
+		),
+		mcp.WithString("extensions",
+			mcp.Description("Comma-separated file extensions searched when no reference resolves (default: .js,.jsx,.ts,.tsx,.mjs,.cjs)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		msgid, err := request.RequireString("msgid")
+		if err != nil {
+			return nil, fmt.Errorf("msgid parameter is required: %w", err)
+		}
+
+		msgctxt := request.GetString("context", "")
+
+		contextLinesStr := request.GetString("context_lines", "5")
+		contextLines, err := strconv.Atoi(contextLinesStr)
+		if err != nil || contextLines < 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid context_lines value: %s", contextLinesStr)), nil
+		}
+
+		extensions := splitList(request.GetString("extensions", ""))
+		if len(extensions) == 0 {
+			extensions = utils.DefaultSourceExtensions
+		}
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		projectRoot := request.GetString("project_root", "")
+		if projectRoot == "" {
+			projectRoot = utils.FindProjectRoot(filePath)
+		}
+
+		var references []string
+		entry := catalog.Lookup(msgctxt, msgid)
+		if entry != nil {
+			references = entry.References
+		}
+
+		usages, err := service.FindUsages(projectRoot, references, msgid, extensions, contextLines)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error reading source files: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"file_path":    filePath,
+			"msgid":        msgid,
+			"context":      msgctxt,
+			"project_root": projectRoot,
+			"in_catalog":   entry != nil,
+			"references":   references,
+			"count":        len(usages),
+			"usages":       usages,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,185 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowUsageTool(t *testing.T) {
+	// Create temporary project with a marker file at the root
+	tempDir, err := os.MkdirTemp("", "po_show_usage_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "package.json"), []byte("{}"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "src", "billing"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "locales"), 0755))
+
+	err = os.WriteFile(filepath.Join(tempDir, "src", "billing", "Invoice.tsx"), []byte(`import { t } from 'i18n';
+
+export function Invoice({ amount }) {
+  // The fee the customer pays
+  return <button>{t('Charge')}</button>;
+}
+`), 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tempDir, "src", "Battery.tsx"), []byte(`const label = t('Charge level');
+`), 0644)
+	require.NoError(t, err)
+
+	poFile := filepath.Join(tempDir, "locales", "de.po")
+	err = os.WriteFile(poFile, []byte(`msgid ""
+msgstr ""
+"Language: de\n"
+
+#: src/billing/Invoice.tsx:5
+msgid "Charge"
+msgstr ""
+
+#: old/path/Battery.tsx
+msgid "Charge level"
+msgstr ""
+
+msgid "Unreferenced"
+msgstr ""
+`), 0644)
+	require.NoError(t, err)
+
+	// Get the tool and handler
+	tool, handler := NewShowUsageTool()
+
+	// Verify tool properties
+	assert.Equal(t, "showUsage", tool.Name)
+	assert.Contains(t, tool.Description, "source code")
+
+	t.Run("Resolve Reference From Project Root", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":     poFile,
+			"msgid":         "Charge",
+			"context_lines": "1",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, true, resultData["in_catalog"])
+		assert.Equal(t, float64(1), resultData["count"])
+
+		usage := resultData["usages"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "src/billing/Invoice.tsx", usage["file"])
+		assert.Equal(t, float64(5), usage["line"])
+		assert.Equal(t, float64(4), usage["start_line"])
+		assert.Equal(t, float64(6), usage["end_line"])
+		assert.Equal(t, "reference", usage["source"])
+		assert.Contains(t, usage["snippet"], ">    5 |   return <button>{t('Charge')}</button>;")
+		assert.Contains(t, usage["snippet"], "// The fee the customer pays")
+	})
+
+	t.Run("Fall Back To Path Suffix", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgid":     "Charge level",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		// The reference directory moved, but Battery.tsx is found by name and the line by text
+		usage := resultData["usages"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "src/Battery.tsx", usage["file"])
+		assert.Equal(t, float64(1), usage["line"])
+	})
+
+	t.Run("Search Source Tree", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"msgid":        "Charge",
+			"context":      "unknown",
+			"project_root": tempDir,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, false, resultData["in_catalog"])
+		// 'Charge level' is another string and does not count as a usage
+		usages := resultData["usages"].([]interface{})
+		require.Len(t, usages, 1)
+		usage := usages[0].(map[string]interface{})
+		assert.Equal(t, "src/billing/Invoice.tsx", usage["file"])
+		assert.Equal(t, "search", usage["source"])
+	})
+
+	t.Run("No Usages", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgid":     "Unreferenced",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(0), resultData["count"])
+	})
+
+	t.Run("Invalid Context Lines", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path":     poFile,
+			"msgid":         "Charge",
+			"context_lines": "-1",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid context_lines value")
+	})
+
+	t.Run("Non-existent File", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": "/non/existent/file.po",
+			"msgid":     "Charge",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error parsing PO file")
+	})
+
+	t.Run("Missing Parameters", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"msgid": "Charge",
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file_path parameter is required")
+
+		_, err = handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "msgid parameter is required")
+	})
+}
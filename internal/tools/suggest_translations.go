@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+// defaultSuggestedTerms is the number of untranslated terms suggested for when no msgids are given
+const defaultSuggestedTerms = 10
+
+func NewSuggestTranslationsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("suggestTranslations",
+		mcp.WithDescription("Suggest translations for msgids from a translation memory built over every .po file of the same language in the project. Returns the most similar already translated msgids with their translation, a similarity score between 0 and 1 and the file they come from."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file being translated; its language selects the catalogs of the memory"),
+		),
+		mcp.WithString("msgids",
+			mcp.Description("JSON array of msgids to suggest translations for (default: the first 10 untranslated terms of the file)"),
+		),
+		mcp.WithString("root",
+			mcp.Description("Directory scanned for .po files (default: the project root of file_path)"),
+		),
+		mcp.WithString("limit",
+			mcp.Description("Maximum number of suggestions per msgid (default: 5)"),
+		),
+		mcp.WithString("min_score",
+			mcp.Description("Lowest similarity score to return, between 0 and 1 (default: 0.5)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		limit, err := strconv.Atoi(request.GetString("limit", "5"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid limit value: %v", err)), nil
+		}
+
+		minScoreStr := request.GetString("min_score", "0.5")
+		minScore, err := strconv.ParseFloat(minScoreStr, 64)
+		if err != nil || minScore < 0 || minScore > 1 {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid min_score value: %s", minScoreStr)), nil
+		}
+
+		catalog, err := utils.ReadCatalog(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		var msgids []string
+		if msgidsStr := request.GetString("msgids", ""); msgidsStr != "" {
+			if err := json.Unmarshal([]byte(msgidsStr), &msgids); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid msgids JSON: %v", err)), nil
+			}
+		} else {
+			for _, entry := range catalog.ActiveEntries() {
+				if len(msgids) == defaultSuggestedTerms {
+					break
+				}
+				if entry.ID != "" && !entry.IsTranslated() {
+					msgids = append(msgids, entry.ID)
+				}
+			}
+		}
+
+		language := utils.NewPoFileInfo(filePath, catalog.Language()).Language
+		if language == "" {
+			return mcp.NewToolResultError("Could not determine the language of the PO file, set its Language header"), nil
+		}
+
+		root := request.GetString("root", "")
+		if root == "" {
+			root = utils.FindProjectRoot(filePath)
+		}
+		memory, err := service.BuildTranslationMemory(root, language)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error building translation memory: %v", err)), nil
+		}
+
+		suggestions := make(map[string][]service.Suggestion, len(msgids))
+		for _, msgid := range msgids {
+			suggestions[msgid] = memory.Suggest(msgid, limit, minScore)
+		}
+
+		result := map[string]any{
+			"file_path":      filePath,
+			"language":       language,
+			"root":           root,
+			"memory_files":   len(memory.Files),
+			"memory_entries": len(memory.Entries),
+			"suggestions":    suggestions,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,147 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestMemoryProject creates a project with a translated German admin catalog,
+// a French catalog and a German web catalog to translate, returning the project root
+func writeTestMemoryProject(t *testing.T, prefix string) string {
+	tempDir, err := os.MkdirTemp("", prefix)
+	require.NoError(t, err)
+
+	files := map[string]string{
+		"package.json": "{}",
+		"admin/de.po": `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgid "Delete file"
+msgstr "Datei löschen"
+
+msgid "Delete folder"
+msgstr "Ordner löschen"
+
+msgid "Open settings"
+msgstr "Einstellungen öffnen"
+`,
+		"admin/fr.po": `msgid ""
+msgstr ""
+"Language: fr\n"
+
+msgid "Delete file"
+msgstr "Supprimer le fichier"
+`,
+		"web/de.po": `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgid "Delete files"
+msgstr ""
+
+msgid "Welcome back"
+msgstr ""
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return tempDir
+}
+
+func TestSuggestTranslationsTool(t *testing.T) {
+	tempDir := writeTestMemoryProject(t, "po_suggest_test")
+	defer os.RemoveAll(tempDir)
+	poFile := filepath.Join(tempDir, "web", "de.po")
+
+	// Get the tool and handler
+	tool, handler := NewSuggestTranslationsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "suggestTranslations", tool.Name)
+	assert.Contains(t, tool.Description, "translation memory")
+
+	t.Run("Untranslated Terms", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, "de", resultData["language"])
+		assert.Equal(t, float64(2), resultData["memory_files"])
+
+		suggestions := resultData["suggestions"].(map[string]interface{})
+		assert.Len(t, suggestions, 2)
+		assert.Empty(t, suggestions["Welcome back"])
+
+		deleteFiles := suggestions["Delete files"].([]interface{})
+		require.Len(t, deleteFiles, 2)
+		best := deleteFiles[0].(map[string]interface{})
+		assert.Equal(t, "Delete file", best["msgid"])
+		assert.Equal(t, "Datei löschen", best["translation"])
+		assert.Equal(t, filepath.Join(tempDir, "admin", "de.po"), best["file"])
+		assert.Greater(t, best["score"], 0.9)
+	})
+
+	t.Run("Given Msgids", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgids":    `["Open setting"]`,
+			"limit":     "1",
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		suggestions := resultData["suggestions"].(map[string]interface{})
+		require.Len(t, suggestions["Open setting"], 1)
+		assert.Equal(t, "Einstellungen öffnen", suggestions["Open setting"].([]interface{})[0].(map[string]interface{})["translation"])
+	})
+
+	t.Run("Min Score", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path": poFile,
+			"msgids":    `["Delete files"]`,
+			"min_score": "0.99",
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Empty(t, resultData["suggestions"].(map[string]interface{})["Delete files"])
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		for name, params := range map[string]map[string]interface{}{
+			"msgids":    {"file_path": poFile, "msgids": "Delete files"},
+			"limit":     {"file_path": poFile, "limit": "many"},
+			"min_score": {"file_path": poFile, "min_score": "2"},
+		} {
+			result, err := handler(context.Background(), makeRequest(params))
+			require.NoError(t, err, name)
+			assert.True(t, result.IsError, name)
+		}
+	})
+
+	t.Run("Missing FilePath Parameter", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		assert.Error(t, err)
+	})
+}
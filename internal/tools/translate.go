@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -14,7 +16,7 @@ import (
 
 func NewTranslateTool() (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("translate",
-		mcp.WithDescription("Translate terms in a PO file and save the changes. You can translate multiple terms at once or updating the existing translation."),
+		mcp.WithDescription("Translate terms in a PO file and save the changes. You can translate multiple terms at once or updating the existing translation. Translations that do not follow the project glossary are saved with a warning."),
 		mcp.WithString("file_path",
 			mcp.Required(),
 			mcp.Description("The path to the .po file"),
@@ -23,6 +25,18 @@ func NewTranslateTool() (mcp.Tool, server.ToolHandlerFunc) {
 			mcp.Required(),
 			mcp.Description("JSON object with translations where keys are term keys and values are translations"),
 		),
+		mcp.WithString("force",
+			mcp.Description("Set to \"true\" to save translations even when validation reports errors such as broken tags, changed link targets or translations over the maximum length (default: false). Characters the file charset cannot represent are always rejected"),
+		),
+		mcp.WithString("dry_run",
+			mcp.Description("Set to \"true\" to validate the translations and return a unified diff of the file instead of writing it (default: false)"),
+		),
+		mcp.WithString("review_state",
+			mcp.Description("Review state set on the changed translations: draft, reviewed, approved or none to leave it unset (default: draft). Draft translations are listed by listPendingReviews"),
+		),
+		mcp.WithString("source",
+			mcp.Description("Where the translations come from, recorded as a provenance comment on the changed entries: ai, human, tm or import (default: ai)"),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -42,37 +56,81 @@ func NewTranslateTool() (mcp.Tool, server.ToolHandlerFunc) {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid translations JSON: %v", err)), nil
 		}
 
+		force, err := strconv.ParseBool(request.GetString("force", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid force value: %v", err)), nil
+		}
+
+		dryRun, err := strconv.ParseBool(request.GetString("dry_run", "false"))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid dry_run value: %v", err)), nil
+		}
+
+		reviewState, err := reviewStateFromRequest(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		provenance, err := provenanceFromRequest(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		// Parse the PO file
-		po, err := utils.ParsePoFile(filePath)
+		catalog, err := utils.ParseCatalogFile(filePath)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
 		}
 
-		// Create PoService instance
-		poService := service.NewPoService(po)
+		projectRoot := utils.FindProjectRoot(filePath)
+		constraints, err := service.LoadConstraints(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading constraints: %v", err)), nil
+		}
 
-		// Apply translations
-		translatedCount := 0
-		for key, value := range translations {
-			poService.Translate(key, value)
-			translatedCount++
+		glossary, err := service.LoadGlossary(projectRoot)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error loading glossary: %v", err)), nil
 		}
 
-		// Get the updated PO file content
-		updatedContent := poService.ToOutput()
+		// Validate and apply translations, rejecting the ones with validation errors
+		outcome := service.ApplyTranslations(catalog, translations, constraints, glossary, force)
+		if err := service.MarkApplied(catalog, outcome.Applied, reviewState); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error setting review state: %v", err)), nil
+		}
+		if err := service.MarkProvenance(catalog, outcome.Applied, provenance); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error recording provenance: %v", err)), nil
+		}
 
 		// Write the updated content back to the file
-		err = os.WriteFile(filePath, []byte(updatedContent), 0644)
+		diff, err := saveCatalog(catalog, filePath, dryRun)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Error writing to PO file: %v", err)), nil
 		}
 
+		message := fmt.Sprintf("Successfully translated %d terms and saved to %s", outcome.TranslatedCount, filePath)
+		if dryRun {
+			message = fmt.Sprintf("Dry run: %d terms would be translated in %s, nothing was written", outcome.TranslatedCount, filePath)
+		}
+		if len(outcome.Rejected) > 0 {
+			message += fmt.Sprintf(". Rejected %d terms with validation errors, fix them or retry with force", len(outcome.Rejected))
+		}
+
 		// Create result object
 		result := map[string]interface{}{
 			"file_path":        filePath,
-			"translated_count": translatedCount,
+			"translated_count": outcome.TranslatedCount,
 			"translations":     translations,
-			"message":          fmt.Sprintf("Successfully translated %d terms and saved to %s", translatedCount, filePath),
+			"rejected":         outcome.Rejected,
+			"issues":           outcome.Issues,
+			"changes":          outcome.Changes,
+			"message":          message,
+		}
+		if dryRun {
+			result["dry_run"] = true
+			result["diff"] = diff
+		} else if err := recordChanges(ctx, tool.Name, filePath, catalog, outcome.Applied); err != nil {
+			result["journal_error"] = fmt.Sprintf("Error recording changes in the journal: %v", err)
 		}
 
 		resultJSON, err := json.MarshalIndent(result, "", "  ")
@@ -85,3 +143,66 @@ func NewTranslateTool() (mcp.Tool, server.ToolHandlerFunc) {
 
 	return tool, handler
 }
+
+// saveCatalog writes a catalog to path. With dryRun the file is left untouched and the
+// unified diff of the change is returned instead.
+func saveCatalog(catalog *utils.Catalog, path string, dryRun bool) (string, error) {
+	if dryRun {
+		return catalog.DiffFile(path)
+	}
+	return "", catalog.WriteFile(path)
+}
+
+// reviewStateFromRequest returns the review_state parameter of a translating tool,
+// "" for none
+func reviewStateFromRequest(request mcp.CallToolRequest) (string, error) {
+	state := request.GetString("review_state", service.ReviewDraft)
+	switch state {
+	case "none":
+		return "", nil
+	case service.ReviewDraft, service.ReviewReviewed, service.ReviewApproved:
+		return state, nil
+	}
+	return "", fmt.Errorf("Invalid review_state value: %s", state)
+}
+
+// provenanceFromRequest returns the provenance recorded on the translations written by
+// a request, from its source parameter and the client of the session
+func provenanceFromRequest(ctx context.Context, request mcp.CallToolRequest) (service.Provenance, error) {
+	source := request.GetString("source", service.SourceAI)
+	if !service.IsProvenanceSource(source) {
+		return service.Provenance{}, fmt.Errorf("Invalid source value: %s", source)
+	}
+	_, client := journalSession(ctx)
+	return service.Provenance{Source: source, Client: client, Time: time.Now().UTC()}, nil
+}
+
+// recordChanges adds the translations applied to a file to the journal of its project
+func recordChanges(ctx context.Context, toolName, path string, catalog *utils.Catalog, applied []service.AppliedTranslation) error {
+	if len(applied) == 0 {
+		return nil
+	}
+	journal, err := service.OpenJournal(utils.FindProjectRoot(path))
+	if err != nil {
+		return err
+	}
+	session, client := journalSession(ctx)
+	return journal.Append(service.TranslationJournalEntries(catalog, applied, path, toolName, session, client))
+}
+
+// journalSession returns the session and client recorded with the changes of a request.
+// Over stdio every connection has the same session ID, so the session of this process
+// is used instead.
+func journalSession(ctx context.Context) (string, string) {
+	session, client := service.ProcessSession, ""
+	if clientSession := server.ClientSessionFromContext(ctx); clientSession != nil {
+		if id := clientSession.SessionID(); id != "" && id != "stdio" {
+			session = id
+		}
+		if withInfo, ok := clientSession.(server.SessionWithClientInfo); ok {
+			info := withInfo.GetClientInfo()
+			client = strings.TrimSpace(info.Name + " " + info.Version)
+		}
+	}
+	return session, client
+}
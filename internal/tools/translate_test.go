@@ -264,3 +264,420 @@ msgstr ""
 		assert.Contains(t, strings.ToLower(textContent), "error writing")
 	})
 }
+
+func TestTranslateToolValidation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_translate_validation_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewTranslateTool()
+
+	poContent := `msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+"Language: es\n"
+
+msgid "Click <b>here</b>"
+msgstr ""
+
+msgid "Read the [docs](https://example.com/docs)"
+msgstr ""
+`
+
+	translations := map[string]string{
+		"Click <b>here</b>":                         "Haz clic <b>aquí",
+		"Read the [docs](https://example.com/docs)": "Lee la [documentación](https://example.com/docs)",
+	}
+	translationsJSON, err := json.Marshal(translations)
+	require.NoError(t, err)
+
+	t.Run("Reject Broken Markup", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "reject.po")
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": string(translationsJSON),
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(1), resultData["translated_count"])
+		assert.Equal(t, []interface{}{"Click <b>here</b>"}, resultData["rejected"])
+		issues := resultData["issues"].([]interface{})
+		require.Len(t, issues, 1)
+		assert.Equal(t, "html_tags", issues[0].(map[string]interface{})["check"])
+		assert.Contains(t, resultData["message"], "Rejected 1 terms")
+
+		updatedContent, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(updatedContent), "documentación")
+		assert.NotContains(t, string(updatedContent), "aquí")
+	})
+
+	t.Run("Force Saves Invalid Markup", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "force.po")
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": string(translationsJSON),
+			"force":        "true",
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(2), resultData["translated_count"])
+		assert.Len(t, resultData["issues"], 1)
+
+		updatedContent, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(updatedContent), "aquí")
+	})
+
+	t.Run("Invalid Force Value", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    filepath.Join(tempDir, "reject.po"),
+			"translations": "{}",
+			"force":        "sometimes",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid force value")
+	})
+}
+
+func TestTranslateToolMaxLength(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_translate_length_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewTranslateTool()
+
+	// Buttons are limited to 10 characters by the project constraints
+	configDir := filepath.Join(tempDir, ".i18n-mcp")
+	require.NoError(t, os.MkdirAll(configDir, 0755))
+	constraints := `{"max_length": [{"reference": "src/buttons/*", "max_length": 10}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "constraints.json"), []byte(constraints), 0644))
+
+	poContent := `msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+"Language: de\n"
+
+#: src/buttons/Save.tsx:4
+msgid "Save changes"
+msgstr ""
+
+#: src/notifications/push.ts:8
+#, max-length:20
+msgid "New message from your team"
+msgstr ""
+
+#: src/pages/Home.tsx:12
+msgid "Welcome"
+msgstr ""
+`
+	translations := map[string]string{
+		"Save changes":               "Änderungen speichern",
+		"New message from your team": "Neue Nachricht deines Teams",
+		"Welcome":                    "Willkommen",
+	}
+	translationsJSON, err := json.Marshal(translations)
+	require.NoError(t, err)
+
+	t.Run("Reject Too Long", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "reject.po")
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": string(translationsJSON),
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, float64(1), resultData["translated_count"])
+		assert.ElementsMatch(t, []interface{}{"Save changes", "New message from your team"}, resultData["rejected"])
+		for _, item := range resultData["issues"].([]interface{}) {
+			assert.Equal(t, "max_length", item.(map[string]interface{})["check"])
+		}
+
+		// Flags and references survive the rewrite
+		updatedContent, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(updatedContent), "#: src/notifications/push.ts:8\n#, max-length:20\n")
+		assert.Contains(t, string(updatedContent), "msgstr \"Willkommen\"")
+		assert.NotContains(t, string(updatedContent), "Änderungen")
+	})
+
+	t.Run("Force Saves Too Long", func(t *testing.T) {
+		poFile := filepath.Join(tempDir, "force.po")
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": string(translationsJSON),
+			"force":        "true",
+		}))
+		require.NoError(t, err)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+		assert.Equal(t, float64(3), resultData["translated_count"])
+		assert.Len(t, resultData["issues"], 2)
+	})
+}
+
+func TestTranslateToolGlossary(t *testing.T) {
+	tempDir := writeTestGlossary(t, "po_translate_glossary_test")
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewTranslateTool()
+
+	poContent := `msgid ""
+msgstr ""
+"Language: de\n"
+
+msgid "Open workspace"
+msgstr ""
+
+msgid "Welcome to Acme"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "de.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	translations := map[string]string{
+		"Open workspace":  "Arbeitsplatz öffnen",
+		"Welcome to Acme": "Willkommen bei Akme",
+	}
+	translationsJSON, err := json.Marshal(translations)
+	require.NoError(t, err)
+
+	result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+		"file_path":    poFile,
+		"translations": string(translationsJSON),
+	}))
+	require.NoError(t, err)
+
+	var resultData map[string]interface{}
+	err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+	require.NoError(t, err)
+
+	// Glossary violations are warnings, the translations are saved
+	assert.Equal(t, float64(2), resultData["translated_count"])
+	assert.Empty(t, resultData["rejected"])
+	checks := []string{}
+	for _, item := range resultData["issues"].([]interface{}) {
+		issue := item.(map[string]interface{})
+		assert.Equal(t, "warning", issue["severity"])
+		checks = append(checks, issue["check"].(string))
+	}
+	assert.ElementsMatch(t, []string{"glossary", "do_not_translate"}, checks)
+}
+
+func TestTranslateToolCharset(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_translate_charset_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewTranslateTool()
+
+	poContent := "msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=ISO-8859-1\\n\"\n\"Language: fr\\n\"\n\nmsgid \"Price\"\nmsgstr \"\"\n\nmsgid \"Delete item\"\nmsgstr \"\"\n"
+	translationsJSON, err := json.Marshal(map[string]string{
+		"Price":       "Prix en €",
+		"Delete item": "Supprimer l'élément",
+	})
+	require.NoError(t, err)
+
+	poFile := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	t.Run("Reject Unrepresentable Characters", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": string(translationsJSON),
+			"force":        "true",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		// Forcing does not help, the file could not be written
+		assert.Equal(t, float64(1), resultData["translated_count"])
+		assert.Equal(t, []interface{}{"Price"}, resultData["rejected"])
+		issues := resultData["issues"].([]interface{})
+		require.Len(t, issues, 1)
+		assert.Equal(t, "charset", issues[0].(map[string]interface{})["check"])
+
+		// The file keeps its charset
+		updatedContent, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(updatedContent), "msgstr \"Supprimer l'\xe9l\xe9ment\"")
+		assert.Contains(t, string(updatedContent), "charset=ISO-8859-1")
+	})
+}
+
+func TestTranslateToolDryRun(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_translate_dry_run_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewTranslateTool()
+
+	poContent := `msgid "hello"
+msgstr ""
+
+msgid "goodbye"
+msgstr "adios"
+
+msgid "thank_you"
+msgstr "gracias"
+
+msgid "Click <b>here</b>"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "es.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	translationsJSON, err := json.Marshal(map[string]string{
+		"hello":             "hola",
+		"goodbye":           "adiós",
+		"thank_you":         "gracias",
+		"welcome":           "bienvenido",
+		"Click <b>here</b>": "Haz clic <b>aquí",
+	})
+	require.NoError(t, err)
+
+	result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+		"file_path":    poFile,
+		"translations": string(translationsJSON),
+		"dry_run":      "true",
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var resultData map[string]interface{}
+	err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, resultData["dry_run"])
+	assert.Equal(t, float64(4), resultData["translated_count"])
+	assert.Contains(t, resultData["message"], "nothing was written")
+	assert.Equal(t, map[string]interface{}{
+		"hello":             "new",
+		"goodbye":           "changed",
+		"thank_you":         "unchanged",
+		"welcome":           "unknown",
+		"Click <b>here</b>": "rejected",
+	}, resultData["changes"])
+
+	diff := resultData["diff"].(string)
+	assert.True(t, strings.HasPrefix(diff, "--- "+poFile+"\n+++ "+poFile+"\n"))
+	assert.Contains(t, diff, "-msgstr \"adios\"\n+msgstr \"adiós\"\n")
+	assert.Contains(t, diff, "+msgid \"welcome\"\n+msgstr \"bienvenido\"\n")
+	assert.NotContains(t, diff, "aquí")
+
+	// The file is untouched
+	content, err := os.ReadFile(poFile)
+	require.NoError(t, err)
+	assert.Equal(t, poContent, string(content))
+
+	t.Run("Invalid Value", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": string(translationsJSON),
+			"dry_run":      "maybe",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid dry_run value")
+	})
+}
+
+func TestTranslateToolProvenance(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_translate_provenance_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	_, handler := NewTranslateTool()
+
+	poFile := filepath.Join(tempDir, "es.po")
+	require.NoError(t, os.WriteFile(poFile, []byte("msgid \"hello\"\nmsgstr \"\"\n\nmsgid \"goodbye\"\nmsgstr \"\"\n"), 0644))
+
+	t.Run("Record Source", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": `{"hello": "hola"}`,
+			"source":       "human",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "# provenance: human; time=")
+
+		// Lookups show the provenance of the match
+		_, lookUp := NewLookUpTranslationTool()
+		result, err = lookUp(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":   poFile,
+			"search_term": "hello",
+		}))
+		require.NoError(t, err)
+		var resultData struct {
+			Matches []struct {
+				MsgID      string `json:"msgid"`
+				Provenance *struct {
+					Source string `json:"source"`
+					Time   string `json:"time"`
+				} `json:"provenance"`
+			} `json:"matches"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextContent(t, result)), &resultData))
+		require.Len(t, resultData.Matches, 1)
+		require.NotNil(t, resultData.Matches[0].Provenance)
+		assert.Equal(t, "human", resultData.Matches[0].Provenance.Source)
+		assert.NotEmpty(t, resultData.Matches[0].Provenance.Time)
+	})
+
+	t.Run("Default Source", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": `{"goodbye": "adiós"}`,
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		content, err := os.ReadFile(poFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "# provenance: ai; time=")
+		assert.Equal(t, 2, strings.Count(string(content), "# provenance: "))
+	})
+
+	t.Run("Invalid Source", func(t *testing.T) {
+		result, err := handler(context.Background(), makeRequest(map[string]interface{}{
+			"file_path":    poFile,
+			"translations": `{"hello": "hola"}`,
+			"source":       "robot",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Invalid source value")
+	})
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/i18n-mcp/internal/service"
+	"github.com/rxtech-lab/i18n-mcp/internal/utils"
+)
+
+func NewValidateTranslationsTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("validateTranslations",
+		mcp.WithDescription("Validate the translations of a PO file. Checks that HTML tags and their nesting, attributes, entities and Markdown link targets in each translation match the source term."),
+		mcp.WithString("file_path",
+			mcp.Required(),
+			mcp.Description("The path to the .po file"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		filePath, err := request.RequireString("file_path")
+		if err != nil {
+			return nil, fmt.Errorf("file_path parameter is required: %w", err)
+		}
+
+		catalog, err := utils.ParseCatalogFile(filePath)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error parsing PO file: %v", err)), nil
+		}
+
+		checked := 0
+		issues := []service.ValidationIssue{}
+		for _, entry := range catalog.ActiveEntries() {
+			if entry.Translation() == "" {
+				continue
+			}
+			checked++
+			issues = append(issues, service.ValidateEntry(entry)...)
+		}
+
+		errorCount := 0
+		for _, issue := range issues {
+			if issue.Severity == service.SeverityError {
+				errorCount++
+			}
+		}
+
+		result := map[string]any{
+			"file_path":     filePath,
+			"language":      catalog.Language(),
+			"checked_count": checked,
+			"error_count":   errorCount,
+			"warning_count": len(issues) - errorCount,
+			"issues":        issues,
+		}
+
+		resultJSON, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error formatting result: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTranslationsTool(t *testing.T) {
+	// Create temporary directory for test files
+	tempDir, err := os.MkdirTemp("", "po_validate_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	poContent := `msgid ""
+msgstr ""
+"Language: fr\n"
+
+msgid "Click <b>here</b>"
+msgstr "Cliquez <b>ici</b>"
+
+msgid "Read the <a href=\"/docs\">docs</a>"
+msgstr "Lisez la <a href=\"/fr/docs\">doc</a>"
+
+msgid "<b><i>Bold italic</i></b>"
+msgstr "<b><i>Gras italique</b></i>"
+
+msgid "See [the guide](https://example.com/guide)"
+msgstr "Voir [le guide](https://example.com/guide-fr)"
+
+msgid "Tom &amp; Jerry"
+msgstr "Tom et Jerry"
+
+msgid "%d file"
+msgid_plural "%d <b>files</b>"
+msgstr[0] "%d fichier"
+msgstr[1] "%d fichiers"
+
+msgid "Untranslated <b>term</b>"
+msgstr ""
+`
+	poFile := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	// Get the tool and handler
+	tool, handler := NewValidateTranslationsTool()
+
+	// Verify tool properties
+	assert.Equal(t, "validateTranslations", tool.Name)
+	assert.Contains(t, tool.Description, "HTML tags")
+
+	t.Run("Report Issues", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": poFile,
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		var resultData map[string]interface{}
+		err = json.Unmarshal([]byte(getTextContent(t, result)), &resultData)
+		require.NoError(t, err)
+
+		assert.Equal(t, "fr", resultData["language"])
+		assert.Equal(t, float64(6), resultData["checked_count"])
+		assert.Equal(t, float64(4), resultData["error_count"])
+		assert.Equal(t, float64(1), resultData["warning_count"])
+
+		checks := make(map[string]string)
+		for _, item := range resultData["issues"].([]interface{}) {
+			issue := item.(map[string]interface{})
+			checks[issue["msgid"].(string)] = issue["check"].(string)
+		}
+		assert.Equal(t, "tag_attributes", checks[`Read the <a href="/docs">docs</a>`])
+		assert.Equal(t, "tag_nesting", checks["<b><i>Bold italic</i></b>"])
+		assert.Equal(t, "markdown_links", checks["See [the guide](https://example.com/guide)"])
+		assert.Equal(t, "html_entities", checks["Tom &amp; Jerry"])
+		assert.Equal(t, "html_tags", checks["%d file"])
+		assert.NotContains(t, checks, "Click <b>here</b>")
+	})
+
+	t.Run("Non-existent File", func(t *testing.T) {
+		request := makeRequest(map[string]interface{}{
+			"file_path": "/non/existent/file.po",
+		})
+
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextContent(t, result), "Error parsing PO file")
+	})
+
+	t.Run("Missing FilePath Parameter", func(t *testing.T) {
+		_, err := handler(context.Background(), makeRequest(map[string]interface{}{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file_path parameter is required")
+	})
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// maxCachedEntries bounds the number of entries of the catalogs kept in memory. The
+// least recently used catalogs are dropped first; they are read back from their index.
+const maxCachedEntries = 1_000_000
+
+// catalogCache keeps the catalogs loaded by ReadCatalog and LoadCatalog in memory, keyed
+// by absolute path. A cached catalog is used as long as the content of its file has the
+// same SHA-256 hash, and is dropped when the file is written through WritePoFile.
+var catalogCache = struct {
+	sync.Mutex
+	files   map[string]*cachedCatalog
+	entries int
+	clock   uint64
+}{files: make(map[string]*cachedCatalog)}
+
+type cachedCatalog struct {
+	sum      [sha256.Size]byte
+	catalog  *Catalog
+	lastUsed uint64
+}
+
+// LoadCatalog returns the catalog of a .po file. It behaves like ParseCatalogFile but
+// reads the catalog from the in-memory cache or the on-disk index of the project when
+// the file did not change since it was last loaded. The returned catalog is an
+// independent copy and can be modified and written back.
+func LoadCatalog(path string) (*Catalog, error) {
+	catalog, err := ReadCatalog(path)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.clone(), nil
+}
+
+// ReadCatalog returns the catalog of a .po file like LoadCatalog without copying it.
+// The catalog is shared with other readers and must not be modified; its lookups use a
+// key index instead of scanning the entries.
+//
+// The catalog is taken from memory, else from the index kept for the file in the data
+// directory, else the file is parsed and the index written. Both are only used while
+// the content of the file has the hash they were built from, so a rewrite is noticed
+// even when it keeps the size and modification time of the file.
+func ReadCatalog(path string) (*Catalog, error) {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		forgetCatalog(path)
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+
+	catalogCache.Lock()
+	cached := catalogCache.files[path]
+	if cached != nil && cached.sum == sum {
+		catalogCache.clock++
+		cached.lastUsed = catalogCache.clock
+		catalogCache.Unlock()
+		return cached.catalog, nil
+	}
+	catalogCache.Unlock()
+
+	catalog, err := readCatalogIndex(path, sum)
+	if err != nil {
+		catalog, err = ParseCatalog(content)
+		if err != nil {
+			forgetCatalog(path)
+			return nil, err
+		}
+		// The index only saves time, the catalog is still usable when it cannot be written
+		_ = writeCatalogIndex(path, sum, catalog)
+	}
+	catalog.indexKeys()
+	cacheCatalog(path, sum, catalog)
+	return catalog, nil
+}
+
+// cacheCatalog stores a catalog in memory and drops the least recently used catalogs
+// while the cache holds more than maxCachedEntries entries
+func cacheCatalog(path string, sum [sha256.Size]byte, catalog *Catalog) {
+	catalogCache.Lock()
+	defer catalogCache.Unlock()
+
+	if old := catalogCache.files[path]; old != nil {
+		catalogCache.entries -= len(old.catalog.Entries)
+	}
+	catalogCache.clock++
+	catalogCache.files[path] = &cachedCatalog{sum: sum, catalog: catalog, lastUsed: catalogCache.clock}
+	catalogCache.entries += len(catalog.Entries)
+
+	for catalogCache.entries > maxCachedEntries && len(catalogCache.files) > 1 {
+		oldest := ""
+		for p, cached := range catalogCache.files {
+			if p != path && (oldest == "" || cached.lastUsed < catalogCache.files[oldest].lastUsed) {
+				oldest = p
+			}
+		}
+		catalogCache.entries -= len(catalogCache.files[oldest].catalog.Entries)
+		delete(catalogCache.files, oldest)
+	}
+}
+
+// forgetCatalog drops the cached catalog of a file
+func forgetCatalog(path string) {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	catalogCache.Lock()
+	defer catalogCache.Unlock()
+	if cached := catalogCache.files[path]; cached != nil {
+		catalogCache.entries -= len(cached.catalog.Entries)
+		delete(catalogCache.files, path)
+	}
+}
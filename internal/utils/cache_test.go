@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogCache(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_cache_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	dataDir := filepath.Join(tempDir, "data")
+	t.Setenv(DataDirEnv, dataDir)
+
+	projectDir := filepath.Join(tempDir, "project")
+	require.NoError(t, os.MkdirAll(projectDir, 0755))
+	poFile := filepath.Join(projectDir, "de.po")
+	poContent := "msgid \"\"\nmsgstr \"\"\n\"Language: de\\n\"\n\n#: src/App.tsx:3\n#,  fuzzy\nmsgid   \"Save\"\nmsgstr \"Speichern\"\n\n#~ msgid \"Old\"\n#~ msgstr \"Alt\"\n"
+	require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+
+	catalog, err := LoadCatalog(poFile)
+	require.NoError(t, err)
+	assert.Equal(t, "Speichern", catalog.Lookup("", "Save").Translation())
+
+	t.Run("Cached Catalog Round Trips", func(t *testing.T) {
+		cached, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(cached.Marshal()))
+
+		// Changes to a returned catalog do not leak into the cache
+		cached.Set("", "Save", "Sichern")
+		cached.Lookup("", "Save").RemoveFlag("fuzzy")
+		again, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Speichern", again.Lookup("", "Save").Translation())
+		assert.Equal(t, poContent, string(again.Marshal()))
+	})
+
+	t.Run("Changed File Is Parsed Again", func(t *testing.T) {
+		changed := []byte("msgid \"Save\"\nmsgstr \"Sichern\"\n")
+		require.NoError(t, os.WriteFile(poFile, changed, 0644))
+
+		catalog, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Sichern", catalog.Lookup("", "Save").Translation())
+	})
+
+	t.Run("Touched File Is Parsed Again", func(t *testing.T) {
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(poFile, later, later))
+
+		catalog, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Sichern", catalog.Lookup("", "Save").Translation())
+	})
+
+	t.Run("Same Size Rewrite Is Parsed Again", func(t *testing.T) {
+		info, err := os.Stat(poFile)
+		require.NoError(t, err)
+		rewritten := []byte("msgid \"Save\"\nmsgstr \"Sicherx\"\n")
+		require.NoError(t, os.WriteFile(poFile, rewritten, 0644))
+		require.NoError(t, os.Chtimes(poFile, info.ModTime(), info.ModTime()))
+
+		catalog, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Sicherx", catalog.Lookup("", "Save").Translation())
+	})
+
+	t.Run("Written Catalog Is Read Again", func(t *testing.T) {
+		catalog, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		catalog.Set("", "Save", "Sichern")
+		require.NoError(t, catalog.WriteFile(poFile))
+
+		read, err := ReadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Sichern", read.Lookup("", "Save").Translation())
+	})
+
+	t.Run("Read Catalog Is Shared", func(t *testing.T) {
+		first, err := ReadCatalog(poFile)
+		require.NoError(t, err)
+		second, err := ReadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+		assert.Nil(t, first.Lookup("", "Missing"))
+	})
+
+	t.Run("Index Round Trips", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(poFile, []byte(poContent), 0644))
+		_, err := ReadCatalog(poFile)
+		require.NoError(t, err)
+		indexPath, err := catalogIndexPath(poFile)
+		require.NoError(t, err)
+		assert.FileExists(t, indexPath)
+
+		// Without the in-memory copy the catalog comes from the index
+		forgetCatalog(poFile)
+		catalog, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(catalog.Marshal()))
+		assert.Equal(t, "Speichern", catalog.Lookup("", "Save").Translation())
+		assert.True(t, catalog.Lookup("", "Save").HasFlag("fuzzy"))
+	})
+
+	t.Run("Damaged Index Is Ignored", func(t *testing.T) {
+		indexPath, err := catalogIndexPath(poFile)
+		require.NoError(t, err)
+		index, err := os.ReadFile(indexPath)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(indexPath, index[:len(index)-5], 0644))
+
+		forgetCatalog(poFile)
+		catalog, err := LoadCatalog(poFile)
+		require.NoError(t, err)
+		assert.Equal(t, poContent, string(catalog.Marshal()))
+
+		// The index is written again
+		rebuilt, err := os.ReadFile(indexPath)
+		require.NoError(t, err)
+		assert.Equal(t, index, rebuilt)
+	})
+
+	t.Run("Scanning Writes Nothing", func(t *testing.T) {
+		scanDataDir := filepath.Join(tempDir, "scan-data")
+		t.Setenv(DataDirEnv, scanDataDir)
+		files, err := ScanPoFilesWithInfo(projectDir)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, "de", files[0].Language)
+		_, err = os.Stat(scanDataDir)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Deleted File", func(t *testing.T) {
+		require.NoError(t, os.Remove(poFile))
+		_, err := LoadCatalog(poFile)
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadCatalog(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_load_catalog_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	poFile := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(poFile, latin1Po, 0644))
+
+	catalog, err := LoadCatalog(poFile)
+	require.NoError(t, err)
+	assert.Equal(t, "ISO-8859-1", catalog.Charset())
+	assert.Equal(t, "Supprimer l'élément", catalog.Lookup("", "Delete").Translation())
+
+	// A cached catalog writes back in its charset
+	catalog, err = LoadCatalog(poFile)
+	require.NoError(t, err)
+	require.NoError(t, catalog.WriteFile(poFile))
+	written, err := os.ReadFile(poFile)
+	require.NoError(t, err)
+	assert.Equal(t, latin1Po, written)
+}
@@ -0,0 +1,804 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CatalogEntry is a single message of a PO/POT file including the comments,
+// flags and references that gotext drops when round-tripping a file
+type CatalogEntry struct {
+	TranslatorComments []string `json:"translator_comments,omitempty"`
+	ExtractedComments  []string `json:"extracted_comments,omitempty"`
+	References         []string `json:"references,omitempty"`
+	Flags              []string `json:"flags,omitempty"`
+	PreviousContext    string   `json:"previous_context,omitempty"`
+	PreviousID         string   `json:"previous_id,omitempty"`
+	PreviousPluralID   string   `json:"previous_plural_id,omitempty"`
+	Context            string   `json:"context,omitempty"`
+	ID                 string   `json:"msgid"`
+	PluralID           string   `json:"msgid_plural,omitempty"`
+	Str                []string `json:"msgstr"`
+	Obsolete           bool     `json:"obsolete,omitempty"`
+
+	// raw keeps the original lines so untouched entries are written back verbatim
+	raw      []string
+	rendered string
+}
+
+// Catalog is an ordered PO/POT file that preserves everything needed to write it back
+type Catalog struct {
+	Header  *CatalogEntry
+	Entries []*CatalogEntry
+
+	// trailing keeps comment lines found after the last entry
+	trailing []string
+	// charset is the charset the file was read in and is written back in
+	charset string
+	// crlf is set when the file was read with CRLF line endings, which are written back
+	crlf bool
+	// keys maps the keys of the active entries to the entries of a shared catalog
+	// returned by ReadCatalog, which is never modified
+	keys map[string]*CatalogEntry
+}
+
+// NewCatalog creates an empty catalog with an empty header entry
+func NewCatalog() *Catalog {
+	return &Catalog{
+		Header: &CatalogEntry{Str: []string{""}},
+	}
+}
+
+// ParseCatalogFile reads and parses a .po/.pot file into a Catalog
+func ParseCatalogFile(path string) (*Catalog, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalog(content)
+}
+
+// ParseCatalog parses the content of a .po/.pot file. Content in another charset than
+// UTF-8 is decoded using the charset of its Content-Type header. The line ending of the
+// first line is kept to write the catalog back.
+func ParseCatalog(content []byte) (*Catalog, error) {
+	charset := DetectCharset(content)
+	content, err := DecodeCharset(content, charset)
+	if err != nil {
+		return nil, err
+	}
+	content = bytes.TrimPrefix(content, utf8BOM)
+
+	newline := bytes.IndexByte(content, '\n')
+	crlf := newline > 0 && content[newline-1] == '\r'
+
+	p := &catalogParser{catalog: &Catalog{charset: charset, crlf: crlf}}
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if err := p.parseLine(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	p.flush()
+	p.catalog.trailing = p.pendingComments()
+	return p.catalog, nil
+}
+
+// Key returns the lookup key of the entry, using the gettext context separator
+func (e *CatalogEntry) Key() string {
+	return EntryKey(e.Context, e.ID)
+}
+
+// EntryKey builds the key used to identify a message by context and msgid
+func EntryKey(context, msgid string) string {
+	if context == "" {
+		return msgid
+	}
+	return context + "\x04" + msgid
+}
+
+// SplitReference splits a "#:" reference such as "src/app.tsx:12" into path and line.
+// The line is 0 when the reference has none.
+func SplitReference(ref string) (string, int) {
+	idx := strings.LastIndex(ref, ":")
+	if idx < 0 {
+		return ref, 0
+	}
+	line, err := strconv.Atoi(ref[idx+1:])
+	if err != nil {
+		return ref, 0
+	}
+	return ref[:idx], line
+}
+
+// Translation returns the singular msgstr of the entry
+func (e *CatalogEntry) Translation() string {
+	if len(e.Str) == 0 {
+		return ""
+	}
+	return e.Str[0]
+}
+
+// SetTranslation sets the singular msgstr of the entry
+func (e *CatalogEntry) SetTranslation(value string) {
+	if len(e.Str) == 0 {
+		e.Str = []string{value}
+		return
+	}
+	e.Str[0] = value
+}
+
+// IsTranslated reports whether every msgstr form of the entry is filled in
+func (e *CatalogEntry) IsTranslated() bool {
+	if len(e.Str) == 0 {
+		return false
+	}
+	for _, s := range e.Str {
+		if s == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// IsFuzzy reports whether the entry carries the fuzzy flag
+func (e *CatalogEntry) IsFuzzy() bool {
+	return e.HasFlag("fuzzy")
+}
+
+// HasFlag reports whether the entry carries the given flag
+func (e *CatalogEntry) HasFlag(flag string) bool {
+	for _, f := range e.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// AddFlag adds a flag to the entry if it is not present yet
+func (e *CatalogEntry) AddFlag(flag string) {
+	if !e.HasFlag(flag) {
+		e.Flags = append(e.Flags, flag)
+	}
+}
+
+// RemoveFlag removes a flag from the entry
+func (e *CatalogEntry) RemoveFlag(flag string) {
+	flags := e.Flags[:0]
+	for _, f := range e.Flags {
+		if f != flag {
+			flags = append(flags, f)
+		}
+	}
+	e.Flags = flags
+}
+
+// FlagValue returns the value of a "name:value" flag such as "max-length:20"
+func (e *CatalogEntry) FlagValue(name string) (string, bool) {
+	for _, f := range e.Flags {
+		if value, ok := strings.CutPrefix(f, name+":"); ok {
+			return strings.TrimSpace(value), true
+		}
+	}
+	return "", false
+}
+
+// SetFlagValue replaces any "name:value" flag with the given value
+func (e *CatalogEntry) SetFlagValue(name, value string) {
+	e.RemoveFlagValue(name)
+	e.Flags = append(e.Flags, name+":"+value)
+}
+
+// RemoveFlagValue removes any "name:value" flag from the entry
+func (e *CatalogEntry) RemoveFlagValue(name string) {
+	flags := e.Flags[:0]
+	for _, f := range e.Flags {
+		if !strings.HasPrefix(f, name+":") {
+			flags = append(flags, f)
+		}
+	}
+	e.Flags = flags
+}
+
+// Lookup returns the active (non-obsolete) entry with the given context and msgid
+func (c *Catalog) Lookup(context, msgid string) *CatalogEntry {
+	if c.keys != nil {
+		return c.keys[EntryKey(context, msgid)]
+	}
+	for _, e := range c.Entries {
+		if !e.Obsolete && e.Context == context && e.ID == msgid {
+			return e
+		}
+	}
+	return nil
+}
+
+// Set sets the singular translation of a message. A missing message is added before
+// the obsolete entries at the end of the catalog.
+func (c *Catalog) Set(context, msgid, value string) *CatalogEntry {
+	entry := c.Lookup(context, msgid)
+	if entry == nil {
+		entry = &CatalogEntry{Context: context, ID: msgid}
+		c.add(entry)
+	}
+	entry.SetTranslation(value)
+	return entry
+}
+
+// add inserts a new entry before the obsolete entries at the end of the catalog
+func (c *Catalog) add(entry *CatalogEntry) {
+	c.Insert(len(c.Entries), entry)
+}
+
+// indexKeys builds the key index of a catalog that will not be modified anymore
+func (c *Catalog) indexKeys() {
+	keys := make(map[string]*CatalogEntry, len(c.Entries))
+	for _, e := range c.Entries {
+		if key := e.Key(); !e.Obsolete && keys[key] == nil {
+			keys[key] = e
+		}
+	}
+	c.keys = keys
+}
+
+// Insert adds an entry at index of the entries, moving it up before the obsolete
+// entries at the end of the catalog when it is active
+func (c *Catalog) Insert(index int, entry *CatalogEntry) {
+	pos := min(max(index, 0), len(c.Entries))
+	for !entry.Obsolete && pos > 0 && c.Entries[pos-1].Obsolete {
+		pos--
+	}
+	c.Entries = slices.Insert(c.Entries, pos, entry)
+}
+
+// Index returns the position of the active entry with the given context and msgid in
+// the entries, or -1
+func (c *Catalog) Index(context, msgid string) int {
+	return slices.IndexFunc(c.Entries, func(e *CatalogEntry) bool {
+		return !e.Obsolete && e.Context == context && e.ID == msgid
+	})
+}
+
+// clone returns a copy of the catalog whose entries can be modified without affecting c.
+// The original lines of the entries are shared since they are never modified.
+func (c *Catalog) clone() *Catalog {
+	clone := &Catalog{trailing: slices.Clone(c.trailing), charset: c.charset, crlf: c.crlf}
+	if c.Header != nil {
+		clone.Header = c.Header.clone()
+	}
+	clone.Entries = make([]*CatalogEntry, len(c.Entries))
+	for i, e := range c.Entries {
+		clone.Entries[i] = e.clone()
+	}
+	return clone
+}
+
+func (e *CatalogEntry) clone() *CatalogEntry {
+	clone := *e
+	clone.TranslatorComments = slices.Clone(e.TranslatorComments)
+	clone.ExtractedComments = slices.Clone(e.ExtractedComments)
+	clone.References = slices.Clone(e.References)
+	clone.Flags = slices.Clone(e.Flags)
+	clone.Str = slices.Clone(e.Str)
+	return &clone
+}
+
+// Remove deletes the active entry with the given context and msgid and reports
+// whether it was found
+func (c *Catalog) Remove(context, msgid string) bool {
+	for i, e := range c.Entries {
+		if !e.Obsolete && e.Context == context && e.ID == msgid {
+			c.Entries = slices.Delete(c.Entries, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveEntries returns all non-obsolete entries in file order
+func (c *Catalog) ActiveEntries() []*CatalogEntry {
+	entries := make([]*CatalogEntry, 0, len(c.Entries))
+	for _, e := range c.Entries {
+		if !e.Obsolete {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// Language returns the Language header of the catalog
+func (c *Catalog) Language() string {
+	return c.HeaderValue("Language")
+}
+
+// HeaderValue returns the value of a header field, matching the key case-insensitively
+func (c *Catalog) HeaderValue(key string) string {
+	for _, field := range c.headerFields() {
+		if strings.EqualFold(field[0], key) {
+			return field[1]
+		}
+	}
+	return ""
+}
+
+// SetHeaderValue sets a header field, keeping the position of an existing field
+func (c *Catalog) SetHeaderValue(key, value string) {
+	if c.Header == nil {
+		c.Header = &CatalogEntry{Str: []string{""}}
+	}
+	fields := c.headerFields()
+	found := false
+	for i, field := range fields {
+		if strings.EqualFold(field[0], key) {
+			fields[i][1] = value
+			found = true
+		}
+	}
+	if !found {
+		fields = append(fields, [2]string{key, value})
+	}
+
+	var b strings.Builder
+	for _, field := range fields {
+		b.WriteString(field[0] + ": " + field[1] + "\n")
+	}
+	c.Header.SetTranslation(b.String())
+}
+
+// headerFields splits the header msgstr into ordered key/value pairs
+func (c *Catalog) headerFields() [][2]string {
+	if c.Header == nil {
+		return nil
+	}
+	var fields [][2]string
+	for _, line := range strings.Split(c.Header.Translation(), "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields = append(fields, [2]string{strings.TrimSpace(key), strings.TrimSpace(value)})
+	}
+	return fields
+}
+
+// Charset returns the charset the catalog is written in
+func (c *Catalog) Charset() string {
+	if c.charset == "" {
+		return DefaultCharset
+	}
+	return c.charset
+}
+
+// SetCharset changes the charset the catalog is written in and updates the
+// charset of the Content-Type header accordingly
+func (c *Catalog) SetCharset(charset string) {
+	c.charset = charset
+	contentType := c.HeaderValue("Content-Type")
+	if loc := charsetPattern.FindStringSubmatchIndex("Content-Type: " + contentType); loc != nil {
+		start, end := loc[2]-len("Content-Type: "), loc[3]-len("Content-Type: ")
+		contentType = contentType[:start] + charset + contentType[end:]
+	} else {
+		contentType = "text/plain; charset=" + charset
+	}
+	c.SetHeaderValue("Content-Type", contentType)
+}
+
+// WriteFile writes the catalog to path, encoded in its charset. Translations with
+// characters the charset cannot represent make the write fail.
+func (c *Catalog) WriteFile(path string) error {
+	return WritePoFile(path, c.Marshal(), c.Charset())
+}
+
+// Marshal returns the PO representation of the catalog. Entries that were not
+// modified since parsing are written back exactly as they were read, with the line
+// endings of the file.
+func (c *Catalog) Marshal() []byte {
+	var blocks []string
+	if c.Header != nil {
+		blocks = append(blocks, c.Header.marshal())
+	}
+	for _, e := range c.Entries {
+		blocks = append(blocks, e.marshal())
+	}
+	if len(c.trailing) > 0 {
+		blocks = append(blocks, strings.Join(c.trailing, "\n"))
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+	content := strings.Join(blocks, "\n\n") + "\n"
+	if c.crlf {
+		content = strings.ReplaceAll(content, "\n", "\r\n")
+	}
+	return []byte(content)
+}
+
+// Block returns the PO lines of the entry as they are written to the file
+func (e *CatalogEntry) Block() string {
+	return e.marshal()
+}
+
+// ParseCatalogEntry parses the PO lines of a single entry, as returned by Block
+func ParseCatalogEntry(block string) (*CatalogEntry, error) {
+	catalog, err := ParseCatalog([]byte(block))
+	if err != nil {
+		return nil, err
+	}
+	if len(catalog.Entries) != 1 {
+		return nil, fmt.Errorf("expected one entry, found %d", len(catalog.Entries))
+	}
+	return catalog.Entries[0], nil
+}
+
+func (e *CatalogEntry) marshal() string {
+	rendered := e.render()
+	if e.raw != nil && rendered == e.rendered {
+		return strings.Join(e.raw, "\n")
+	}
+	return rendered
+}
+
+// render writes the entry in the canonical gettext layout
+func (e *CatalogEntry) render() string {
+	var lines []string
+	for _, c := range e.TranslatorComments {
+		if c == "" {
+			lines = append(lines, "#")
+		} else {
+			lines = append(lines, "# "+c)
+		}
+	}
+	for _, c := range e.ExtractedComments {
+		lines = append(lines, "#. "+c)
+	}
+	if len(e.References) > 0 {
+		lines = append(lines, "#: "+strings.Join(e.References, " "))
+	}
+	if len(e.Flags) > 0 {
+		lines = append(lines, "#, "+strings.Join(e.Flags, ", "))
+	}
+
+	prefix := ""
+	if e.Obsolete {
+		prefix = "#~ "
+	}
+	if e.PreviousContext != "" {
+		lines = append(lines, quoteField(prefix+"#| msgctxt", e.PreviousContext, prefix+"#| ")...)
+	}
+	if e.PreviousID != "" {
+		lines = append(lines, quoteField(prefix+"#| msgid", e.PreviousID, prefix+"#| ")...)
+	}
+	if e.PreviousPluralID != "" {
+		lines = append(lines, quoteField(prefix+"#| msgid_plural", e.PreviousPluralID, prefix+"#| ")...)
+	}
+	if e.Context != "" {
+		lines = append(lines, quoteField(prefix+"msgctxt", e.Context, prefix)...)
+	}
+	lines = append(lines, quoteField(prefix+"msgid", e.ID, prefix)...)
+	if e.PluralID != "" {
+		lines = append(lines, quoteField(prefix+"msgid_plural", e.PluralID, prefix)...)
+		str := e.Str
+		if len(str) == 0 {
+			str = []string{"", ""}
+		}
+		for i, s := range str {
+			lines = append(lines, quoteField(fmt.Sprintf("%smsgstr[%d]", prefix, i), s, prefix)...)
+		}
+	} else {
+		lines = append(lines, quoteField(prefix+"msgstr", e.Translation(), prefix)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// quoteField renders a keyword and its string, splitting multi-line values after each \n
+func quoteField(keyword, value, continuation string) []string {
+	parts := splitAfterNewlines(value)
+	if len(parts) <= 1 {
+		return []string{keyword + " " + quotePoString(value)}
+	}
+	lines := []string{keyword + ` ""`}
+	for _, part := range parts {
+		lines = append(lines, continuation+quotePoString(part))
+	}
+	return lines
+}
+
+func splitAfterNewlines(value string) []string {
+	var parts []string
+	for {
+		idx := strings.Index(value, "\n")
+		if idx < 0 || idx == len(value)-1 {
+			break
+		}
+		parts = append(parts, value[:idx+1])
+		value = value[idx+1:]
+	}
+	return append(parts, value)
+}
+
+// quotePoString escapes a value as a double-quoted PO string
+func quotePoString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// unquotePoString decodes a double-quoted PO string
+func unquotePoString(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("invalid quoted string %s", s)
+	}
+	s = s[1 : len(s)-1]
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'v':
+			b.WriteByte('\v')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+type catalogField int
+
+const (
+	fieldNone catalogField = iota
+	fieldContext
+	fieldID
+	fieldPluralID
+	fieldStr
+	fieldPrevContext
+	fieldPrevID
+	fieldPrevPluralID
+)
+
+type catalogParser struct {
+	catalog  *Catalog
+	current  *CatalogEntry
+	raw      []string
+	field    catalogField
+	strIndex int
+	// hasKeyword is set once the current entry has seen a msgctxt/msgid line
+	hasKeyword bool
+}
+
+func (p *catalogParser) entry() *CatalogEntry {
+	if p.current == nil {
+		p.current = &CatalogEntry{}
+	}
+	return p.current
+}
+
+func (p *catalogParser) parseLine(line string) error {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		p.flush()
+		return nil
+	}
+
+	obsolete := false
+	body := trimmed
+	if strings.HasPrefix(body, "#~") {
+		obsolete = true
+		body = strings.TrimSpace(body[2:])
+	}
+
+	// A new comment or keyword after msgstr starts a new entry even without a blank line
+	if p.field == fieldStr && !strings.HasPrefix(body, `"`) && !strings.HasPrefix(body, "msgstr") {
+		p.flush()
+	}
+
+	p.raw = append(p.raw, line)
+	e := p.entry()
+	if obsolete {
+		e.Obsolete = true
+	}
+
+	switch {
+	case strings.HasPrefix(body, "#|"):
+		return p.parsePrevious(strings.TrimSpace(body[2:]))
+	case strings.HasPrefix(body, "#."):
+		e.ExtractedComments = append(e.ExtractedComments, strings.TrimSpace(body[2:]))
+	case strings.HasPrefix(body, "#:"):
+		e.References = append(e.References, strings.Fields(body[2:])...)
+	case strings.HasPrefix(body, "#,"):
+		for _, flag := range strings.Split(body[2:], ",") {
+			if flag = strings.TrimSpace(flag); flag != "" {
+				e.Flags = append(e.Flags, flag)
+			}
+		}
+	case strings.HasPrefix(body, "#"):
+		if obsolete {
+			// "#~" without a keyword is an obsolete comment line
+			return nil
+		}
+		comment := strings.TrimPrefix(body[1:], " ")
+		e.TranslatorComments = append(e.TranslatorComments, comment)
+	case strings.HasPrefix(body, `"`):
+		value, err := unquotePoString(body)
+		if err != nil {
+			return err
+		}
+		p.appendString(value)
+	default:
+		return p.parseKeyword(body)
+	}
+	return nil
+}
+
+func (p *catalogParser) parseKeyword(body string) error {
+	e := p.entry()
+	keyword, rest, _ := strings.Cut(body, " ")
+	rest = strings.TrimSpace(rest)
+	value, err := unquotePoString(rest)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case keyword == "msgctxt":
+		p.field = fieldContext
+		e.Context = value
+		p.hasKeyword = true
+	case keyword == "msgid":
+		p.field = fieldID
+		e.ID = value
+		p.hasKeyword = true
+	case keyword == "msgid_plural":
+		p.field = fieldPluralID
+		e.PluralID = value
+	case keyword == "msgstr":
+		p.field = fieldStr
+		p.strIndex = 0
+		e.Str = []string{value}
+	case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+		var index int
+		if _, err := fmt.Sscanf(keyword, "msgstr[%d]", &index); err != nil || index < 0 {
+			return fmt.Errorf("invalid plural index in %q", keyword)
+		}
+		for len(e.Str) <= index {
+			e.Str = append(e.Str, "")
+		}
+		p.field = fieldStr
+		p.strIndex = index
+		e.Str[index] = value
+	default:
+		return fmt.Errorf("unexpected keyword %q", keyword)
+	}
+	return nil
+}
+
+func (p *catalogParser) parsePrevious(body string) error {
+	e := p.entry()
+	if strings.HasPrefix(body, `"`) {
+		value, err := unquotePoString(body)
+		if err != nil {
+			return err
+		}
+		p.appendString(value)
+		return nil
+	}
+
+	keyword, rest, _ := strings.Cut(body, " ")
+	value, err := unquotePoString(rest)
+	if err != nil {
+		return err
+	}
+	switch keyword {
+	case "msgctxt":
+		p.field = fieldPrevContext
+		e.PreviousContext = value
+	case "msgid":
+		p.field = fieldPrevID
+		e.PreviousID = value
+	case "msgid_plural":
+		p.field = fieldPrevPluralID
+		e.PreviousPluralID = value
+	default:
+		return fmt.Errorf("unexpected previous keyword %q", keyword)
+	}
+	return nil
+}
+
+func (p *catalogParser) appendString(value string) {
+	e := p.entry()
+	switch p.field {
+	case fieldContext:
+		e.Context += value
+	case fieldID:
+		e.ID += value
+	case fieldPluralID:
+		e.PluralID += value
+	case fieldStr:
+		e.Str[p.strIndex] += value
+	case fieldPrevContext:
+		e.PreviousContext += value
+	case fieldPrevID:
+		e.PreviousID += value
+	case fieldPrevPluralID:
+		e.PreviousPluralID += value
+	}
+}
+
+// pendingComments returns the raw lines of a buffered entry that never got a msgid
+func (p *catalogParser) pendingComments() []string {
+	if p.current == nil || p.hasKeyword {
+		return nil
+	}
+	raw := p.raw
+	for len(raw) > 0 && raw[len(raw)-1] == "" {
+		raw = raw[:len(raw)-1]
+	}
+	return raw
+}
+
+// flush stores the buffered entry in the catalog
+func (p *catalogParser) flush() {
+	e := p.current
+	if e == nil {
+		return
+	}
+	if !p.hasKeyword {
+		// Comments without a message stay attached to the next entry
+		p.raw = append(p.raw, "")
+		return
+	}
+	if e.Str == nil {
+		e.Str = []string{""}
+	}
+	e.raw = p.raw
+	e.rendered = e.render()
+
+	if e.ID == "" && e.Context == "" && !e.Obsolete && p.catalog.Header == nil && len(p.catalog.Entries) == 0 {
+		p.catalog.Header = e
+	} else {
+		p.catalog.Entries = append(p.catalog.Entries, e)
+	}
+
+	p.current = nil
+	p.raw = nil
+	p.field = fieldNone
+	p.hasKeyword = false
+}
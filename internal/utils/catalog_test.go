@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const catalogContent = `# Translation file
+msgid ""
+msgstr ""
+"Language: fr\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Plural-Forms: nplurals=2; plural=(n > 1);\n"
+
+# Shown on the dashboard
+#. translators: greeting
+#: app/index.tsx:10 app/other.tsx:5
+#, fuzzy, max-length:20
+#| msgid "Hi"
+msgid "Hello"
+msgstr "Bonjour"
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Ouvrir"
+
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d fichier"
+msgstr[1] "%d fichiers"
+
+msgid ""
+"Multi-line\n"
+"message"
+msgstr ""
+
+#~ msgid "Old"
+#~ msgstr "Ancien"
+`
+
+func TestParseCatalog(t *testing.T) {
+	catalog, err := ParseCatalog([]byte(catalogContent))
+	require.NoError(t, err)
+
+	t.Run("Header", func(t *testing.T) {
+		require.NotNil(t, catalog.Header)
+		assert.Equal(t, "fr", catalog.Language())
+		assert.Equal(t, "nplurals=2; plural=(n > 1);", catalog.HeaderValue("plural-forms"))
+		assert.Equal(t, []string{"Translation file"}, catalog.Header.TranslatorComments)
+	})
+
+	t.Run("Comments and flags", func(t *testing.T) {
+		entry := catalog.Lookup("", "Hello")
+		require.NotNil(t, entry)
+		assert.Equal(t, "Bonjour", entry.Translation())
+		assert.Equal(t, []string{"Shown on the dashboard"}, entry.TranslatorComments)
+		assert.Equal(t, []string{"translators: greeting"}, entry.ExtractedComments)
+		assert.Equal(t, []string{"app/index.tsx:10", "app/other.tsx:5"}, entry.References)
+		assert.True(t, entry.IsFuzzy())
+		assert.Equal(t, "Hi", entry.PreviousID)
+
+		value, ok := entry.FlagValue("max-length")
+		assert.True(t, ok)
+		assert.Equal(t, "20", value)
+	})
+
+	t.Run("Context, plural and multi-line entries", func(t *testing.T) {
+		assert.Nil(t, catalog.Lookup("", "Open"))
+		assert.Equal(t, "Ouvrir", catalog.Lookup("menu", "Open").Translation())
+
+		plural := catalog.Lookup("", "%d file")
+		require.NotNil(t, plural)
+		assert.Equal(t, "%d files", plural.PluralID)
+		assert.Equal(t, []string{"%d fichier", "%d fichiers"}, plural.Str)
+
+		multi := catalog.Lookup("", "Multi-line\nmessage")
+		require.NotNil(t, multi)
+		assert.False(t, multi.IsTranslated())
+	})
+
+	t.Run("Obsolete entries", func(t *testing.T) {
+		assert.Nil(t, catalog.Lookup("", "Old"))
+		assert.Len(t, catalog.Entries, 5)
+		assert.Len(t, catalog.ActiveEntries(), 4)
+		assert.True(t, catalog.Entries[4].Obsolete)
+		assert.Equal(t, "Ancien", catalog.Entries[4].Translation())
+	})
+}
+
+func TestCatalogMarshal(t *testing.T) {
+	t.Run("Round-trips unmodified content", func(t *testing.T) {
+		catalog, err := ParseCatalog([]byte(catalogContent))
+		require.NoError(t, err)
+		assert.Equal(t, catalogContent, string(catalog.Marshal()))
+	})
+
+	t.Run("Rewrites modified entries only", func(t *testing.T) {
+		catalog, err := ParseCatalog([]byte(catalogContent))
+		require.NoError(t, err)
+
+		entry := catalog.Lookup("", "Hello")
+		entry.SetTranslation("Salut \"toi\"")
+		entry.RemoveFlag("fuzzy")
+		catalog.Lookup("", "Multi-line\nmessage").SetTranslation("Multi\nligne")
+
+		output := string(catalog.Marshal())
+		assert.Contains(t, output, "#, max-length:20\n#| msgid \"Hi\"\nmsgid \"Hello\"\nmsgstr \"Salut \\\"toi\\\"\"")
+		assert.Contains(t, output, "msgstr \"\"\n\"Multi\\n\"\n\"ligne\"")
+		assert.Contains(t, output, "msgctxt \"menu\"\nmsgid \"Open\"\nmsgstr \"Ouvrir\"")
+		assert.Contains(t, output, "#~ msgid \"Old\"")
+
+		reparsed, err := ParseCatalog([]byte(output))
+		require.NoError(t, err)
+		assert.Equal(t, "Salut \"toi\"", reparsed.Lookup("", "Hello").Translation())
+		assert.Equal(t, "Multi\nligne", reparsed.Lookup("", "Multi-line\nmessage").Translation())
+	})
+
+	t.Run("Updates header fields", func(t *testing.T) {
+		catalog, err := ParseCatalog([]byte(catalogContent))
+		require.NoError(t, err)
+
+		catalog.SetHeaderValue("Language", "de")
+		catalog.SetHeaderValue("X-Generator", "i18n-mcp")
+
+		reparsed, err := ParseCatalog(catalog.Marshal())
+		require.NoError(t, err)
+		assert.Equal(t, "de", reparsed.Language())
+		assert.Equal(t, "i18n-mcp", reparsed.HeaderValue("X-Generator"))
+		assert.Equal(t, "text/plain; charset=UTF-8", reparsed.HeaderValue("Content-Type"))
+	})
+
+	t.Run("Adds missing entries before obsolete ones", func(t *testing.T) {
+		catalog, err := ParseCatalog([]byte(catalogContent))
+		require.NoError(t, err)
+
+		catalog.Set("", "Hello", "Salut")
+		catalog.Set("", "New", "Nouveau")
+
+		assert.Len(t, catalog.Entries, 6)
+		assert.Equal(t, "New", catalog.Entries[4].ID)
+		assert.True(t, catalog.Entries[5].Obsolete)
+		assert.Equal(t, "Salut", catalog.Lookup("", "Hello").Translation())
+		assert.Contains(t, string(catalog.Marshal()), "msgid \"New\"\nmsgstr \"Nouveau\"\n\n#~ msgid \"Old\"")
+	})
+
+	t.Run("Keeps CRLF line endings", func(t *testing.T) {
+		content := strings.ReplaceAll(catalogContent, "\n", "\r\n")
+		catalog, err := ParseCatalog([]byte(content))
+		require.NoError(t, err)
+		assert.Equal(t, content, string(catalog.Marshal()))
+
+		catalog.Lookup("", "Hello").SetTranslation("Salut")
+		catalog.Set("", "New", "Nouveau")
+		output := string(catalog.Marshal())
+		assert.Contains(t, output, "msgid \"New\"\r\nmsgstr \"Nouveau\"\r\n\r\n#~ msgid \"Old\"")
+		assert.NotRegexp(t, "[^\r]\n", output)
+	})
+}
+
+func TestParseCatalogFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "catalog_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	t.Run("Reads file", func(t *testing.T) {
+		path := filepath.Join(tempDir, "fr.po")
+		require.NoError(t, os.WriteFile(path, []byte(catalogContent), 0644))
+
+		catalog, err := ParseCatalogFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "fr", catalog.Language())
+	})
+
+	t.Run("Non-existent file", func(t *testing.T) {
+		_, err := ParseCatalogFile(filepath.Join(tempDir, "missing.po"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid content", func(t *testing.T) {
+		_, err := ParseCatalog([]byte("This is not valid PO content"))
+		assert.Error(t, err)
+	})
+}
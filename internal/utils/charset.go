@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// DefaultCharset is used for files without a charset in their Content-Type header
+const DefaultCharset = "UTF-8"
+
+// charsetPattern finds the charset of the Content-Type header. The header is ASCII in
+// every charset gettext supports, so it can be read before the content is decoded.
+var charsetPattern = regexp.MustCompile(`(?i)Content-Type:[^"\\]*charset=([A-Za-z0-9_.:-]+)`)
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// charsetAliases maps common charset names the IANA registry does not know
+var charsetAliases = map[string]string{
+	"ascii":  "US-ASCII",
+	"utf8":   "UTF-8",
+	"latin1": "ISO-8859-1",
+	"latin2": "ISO-8859-2",
+}
+
+// DetectCharset returns the charset declared in the Content-Type header of PO content.
+// Missing charsets and the template placeholder "CHARSET" are reported as UTF-8.
+func DetectCharset(content []byte) string {
+	m := charsetPattern.FindSubmatch(content)
+	if m == nil || strings.EqualFold(string(m[1]), "CHARSET") {
+		return DefaultCharset
+	}
+	return string(m[1])
+}
+
+// IsUTF8Charset reports whether a charset name stands for UTF-8
+func IsUTF8Charset(charset string) bool {
+	name := strings.ToLower(strings.ReplaceAll(charset, "-", ""))
+	return name == "" || name == "utf8"
+}
+
+// LookupCharset returns the encoding of a charset name such as ISO-8859-1, CP1251 or KOI8-R
+func LookupCharset(charset string) (encoding.Encoding, error) {
+	name := charset
+	if alias, ok := charsetAliases[strings.ToLower(name)]; ok {
+		name = alias
+	}
+	if lower := strings.ToLower(name); strings.HasPrefix(lower, "cp") && len(lower) == 6 {
+		// CP1251 and friends are the Windows code pages
+		name = "windows-" + lower[2:]
+	}
+
+	// The IANA index is exact; the HTML index maps some names to supersets
+	// (ISO-8859-1 to windows-1252) but knows more aliases
+	if enc, err := ianaindex.IANA.Encoding(name); err == nil && enc != nil {
+		return enc, nil
+	}
+	if enc, err := htmlindex.Get(name); err == nil && enc != nil {
+		return enc, nil
+	}
+	return nil, fmt.Errorf("unsupported charset %q", charset)
+}
+
+// DecodeCharset converts PO content in the given charset to UTF-8
+func DecodeCharset(content []byte, charset string) ([]byte, error) {
+	if IsUTF8Charset(charset) {
+		return content, nil
+	}
+	enc, err := LookupCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := enc.NewDecoder().Bytes(content)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", charset, err)
+	}
+	return decoded, nil
+}
+
+// EncodeCharset converts UTF-8 content to the given charset. Characters the charset
+// cannot represent are an error.
+func EncodeCharset(content []byte, charset string) ([]byte, error) {
+	if IsUTF8Charset(charset) {
+		return content, nil
+	}
+	enc, err := LookupCharset(charset)
+	if err != nil {
+		return nil, err
+	}
+	if missing := UnrepresentableRunes(string(content), charset); len(missing) > 0 {
+		return nil, fmt.Errorf("characters %q cannot be represented in %s", string(missing), charset)
+	}
+	encoded, err := enc.NewEncoder().Bytes(content)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", charset, err)
+	}
+	return encoded, nil
+}
+
+// UnrepresentableRunes returns the distinct characters of s that the charset cannot
+// represent, in order of appearance. Unknown charsets report nothing.
+func UnrepresentableRunes(s, charset string) []rune {
+	if IsUTF8Charset(charset) {
+		return nil
+	}
+	enc, err := LookupCharset(charset)
+	if err != nil {
+		return nil
+	}
+	encoder := enc.NewEncoder()
+	var missing []rune
+	seen := make(map[rune]bool)
+	for _, r := range s {
+		if r < utf8.RuneSelf && r != 0 || seen[r] {
+			continue
+		}
+		seen[r] = true
+		if _, err := encoder.String(string(r)); err != nil {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
+// ReadPoFile reads a .po file and returns its content decoded to UTF-8 together
+// with the charset declared in its header
+func ReadPoFile(path string) ([]byte, string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	charset := DetectCharset(content)
+	decoded, err := DecodeCharset(content, charset)
+	if err != nil {
+		return nil, "", err
+	}
+	// Drop a UTF-8 byte order mark so the first line parses
+	return bytes.TrimPrefix(decoded, utf8BOM), charset, nil
+}
+
+// WritePoFile encodes UTF-8 content in the given charset and writes it to path. The
+// cached catalog of the file is dropped.
+func WritePoFile(path string, content []byte, charset string) error {
+	encoded, err := EncodeCharset(content, charset)
+	if err != nil {
+		return err
+	}
+	defer forgetCatalog(path)
+	return os.WriteFile(path, encoded, 0644)
+}
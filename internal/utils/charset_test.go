@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// latin1Po is a French catalog encoded in ISO-8859-1
+var latin1Po = []byte("msgid \"\"\nmsgstr \"\"\n\"Language: fr\\n\"\n\"Content-Type: text/plain; charset=ISO-8859-1\\n\"\n\nmsgid \"Delete\"\nmsgstr \"Supprimer l'\xe9l\xe9ment\"\n")
+
+func TestDetectCharset(t *testing.T) {
+	assert.Equal(t, "ISO-8859-1", DetectCharset(latin1Po))
+	assert.Equal(t, "UTF-8", DetectCharset([]byte(`"Content-Type: text/plain; charset=CHARSET\n"`)))
+	assert.Equal(t, "UTF-8", DetectCharset([]byte(`msgid "Hello"`)))
+	assert.Equal(t, "cp1251", DetectCharset([]byte(`"Content-Type: text/plain; charset=cp1251\n"`)))
+}
+
+func TestLookupCharset(t *testing.T) {
+	for _, name := range []string{"ISO-8859-1", "latin1", "CP1251", "windows-1252", "KOI8-R", "ascii"} {
+		enc, err := LookupCharset(name)
+		assert.NoError(t, err, name)
+		assert.NotNil(t, enc, name)
+	}
+
+	_, err := LookupCharset("no-such-charset")
+	assert.Error(t, err)
+}
+
+func TestEncodeDecodeCharset(t *testing.T) {
+	decoded, err := DecodeCharset([]byte("\xcf\xf0\xe8\xe2\xe5\xf2"), "CP1251")
+	require.NoError(t, err)
+	assert.Equal(t, "Привет", string(decoded))
+
+	encoded, err := EncodeCharset(decoded, "CP1251")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\xcf\xf0\xe8\xe2\xe5\xf2"), encoded)
+
+	_, err = EncodeCharset([]byte("Prix : 5 €"), "ISO-8859-1")
+	assert.ErrorContains(t, err, "€")
+
+	assert.Equal(t, []rune{'€', '日'}, UnrepresentableRunes("€ é 日 €", "ISO-8859-1"))
+	assert.Empty(t, UnrepresentableRunes("€ é 日", "UTF-8"))
+	assert.Equal(t, []rune{'é'}, UnrepresentableRunes("café", "US-ASCII"))
+}
+
+func TestReadWritePoFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po_charset_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	path := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(path, latin1Po, 0644))
+
+	content, charset, err := ReadPoFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "ISO-8859-1", charset)
+	assert.Contains(t, string(content), "Supprimer l'élément")
+
+	require.NoError(t, WritePoFile(path, content, charset))
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, latin1Po, written)
+
+	t.Run("Catalog Round Trip", func(t *testing.T) {
+		catalog, err := ParseCatalogFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "ISO-8859-1", catalog.Charset())
+		assert.Equal(t, "Supprimer l'élément", catalog.Lookup("", "Delete").Translation())
+
+		catalog.Lookup("", "Delete").SetTranslation("Supprimer €")
+		assert.Error(t, catalog.WriteFile(path))
+
+		catalog.SetCharset(DefaultCharset)
+		assert.Equal(t, "text/plain; charset=UTF-8", catalog.HeaderValue("Content-Type"))
+		require.NoError(t, catalog.WriteFile(path))
+		written, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(written), "Supprimer €")
+	})
+
+	t.Run("Byte Order Mark", func(t *testing.T) {
+		bomPath := filepath.Join(tempDir, "de.po")
+		require.NoError(t, os.WriteFile(bomPath, []byte("\xef\xbb\xbfmsgid \"Hello\"\nmsgstr \"Hallo\"\n"), 0644))
+
+		catalog, err := ParseCatalogFile(bomPath)
+		require.NoError(t, err)
+		assert.Equal(t, "Hallo", catalog.Lookup("", "Hello").Translation())
+
+		po, err := ParsePoFile(bomPath)
+		require.NoError(t, err)
+		assert.Equal(t, "Hallo", po.Get("Hello"))
+	})
+}
@@ -0,0 +1,268 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+// diffLine is a line of a diff: ' ' for unchanged, '-' for removed and '+' for added
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// UnifiedDiff returns the changes from old to new in unified diff format, labelled with
+// oldName and newName. It returns an empty string when both are equal.
+func UnifiedDiff(oldName, newName string, old, new []byte) string {
+	if string(old) == string(new) {
+		return ""
+	}
+	lines := diffLines(splitLines(string(old)), splitLines(string(new)))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+	oldLine, newLine := 0, 0
+	for start := 0; start < len(lines); {
+		// Find the next change and the end of the hunk around it
+		first := start
+		for first < len(lines) && lines[first].kind == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for i := first + 1; i < len(lines) && i <= last+2*diffContext; i++ {
+			if lines[i].kind != ' ' {
+				last = i
+			}
+		}
+		from, to := max(first-diffContext, start), min(last+diffContext+1, len(lines))
+
+		// Count the lines before the hunk
+		for _, line := range lines[start:from] {
+			oldLine, newLine = advance(line, oldLine, newLine)
+		}
+		oldStart, newStart := oldLine, newLine
+		for _, line := range lines[from:to] {
+			oldLine, newLine = advance(line, oldLine, newLine)
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(oldStart, oldLine-oldStart), hunkRange(newStart, newLine-newStart))
+		for _, line := range lines[from:to] {
+			sb.WriteByte(line.kind)
+			sb.WriteString(line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		start = to
+	}
+	return sb.String()
+}
+
+// DiffFile returns the unified diff between the file at path and the catalog as
+// WriteFile would write it, without writing anything. It fails like WriteFile when the
+// charset of the catalog cannot represent a translation.
+func (c *Catalog) DiffFile(path string) (string, error) {
+	content := c.Marshal()
+	if _, err := EncodeCharset(content, c.Charset()); err != nil {
+		return "", err
+	}
+	return DiffWithFile(path, content)
+}
+
+// DiffWithFile returns the unified diff between the file at path, decoded from its
+// charset, and content. A missing file is shown as /dev/null.
+func DiffWithFile(path string, content []byte) (string, error) {
+	oldName := path
+	existing, _, err := ReadPoFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		oldName = "/dev/null"
+	} else if err != nil {
+		return "", err
+	}
+	return UnifiedDiff(oldName, path, existing, content), nil
+}
+
+// splitLines splits text into lines, keeping the line terminators
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func advance(line diffLine, oldLine, newLine int) (int, int) {
+	if line.kind != '+' {
+		oldLine++
+	}
+	if line.kind != '-' {
+		newLine++
+	}
+	return oldLine, newLine
+}
+
+// hunkRange formats the range of a hunk header. An empty range starts at the line
+// before it, as in diff -u.
+func hunkRange(before, count int) string {
+	start := before + 1
+	if count == 0 {
+		start = before
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// diffLines computes the shortest edit script turning a into b with the linear space
+// variant of the Myers algorithm, which splits the problem at the middle snake of the
+// edit path so memory stays proportional to the input. Common leading and trailing
+// lines are skipped first, so small changes to large files stay cheap.
+func diffLines(a, b []string) []diffLine {
+	lines := appendDiff(make([]diffLine, 0, len(a)+len(b)), a, b)
+
+	// The halves of a split can put additions before deletions, list the deletions of
+	// each run of changes first as diff -u does
+	for start := 0; start < len(lines); {
+		if lines[start].kind == ' ' {
+			start++
+			continue
+		}
+		end := start
+		for end < len(lines) && lines[end].kind != ' ' {
+			end++
+		}
+		slices.SortStableFunc(lines[start:end], func(x, y diffLine) int {
+			return int(y.kind) - int(x.kind)
+		})
+		start = end
+	}
+	return lines
+}
+
+// appendDiff appends the edit script turning a into b to lines
+func appendDiff(lines []diffLine, a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	for _, text := range a[:prefix] {
+		lines = append(lines, diffLine{' ', text})
+	}
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	switch {
+	case len(midA) == 0:
+		for _, text := range midB {
+			lines = append(lines, diffLine{'+', text})
+		}
+	case len(midB) == 0:
+		for _, text := range midA {
+			lines = append(lines, diffLine{'-', text})
+		}
+	default:
+		x, y := middleSnake(midA, midB)
+		lines = appendDiff(lines, midA[:x], midB[:y])
+		lines = appendDiff(lines, midA[x:], midB[y:])
+	}
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', text})
+	}
+	return lines
+}
+
+// middleSnake runs the Myers search from both ends of a and b at once and returns the
+// point where the forward and the reverse paths meet, which lies on a shortest edit
+// path. a and b must be non-empty and differ in their first and last lines.
+func middleSnake(a, b []string) (int, int) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD + 1
+	// forward[k] and reverse[k] keep the furthest x reached on diagonal k from the start
+	// and from the end, -1 when the diagonal was not reached yet
+	forward := make([]int, 2*offset+1)
+	reverse := make([]int, 2*offset+1)
+	for i := range forward {
+		forward[i], reverse[i] = -1, -1
+	}
+	forward[offset+1], reverse[offset+1] = 0, 0
+	delta := n - m
+	// With an odd delta the paths can only meet on a forward step, otherwise on a
+	// reverse step
+	odd := delta%2 != 0
+
+	// The diagonals that ran past the end of a or b are not extended any further
+	kStart, kEnd, rStart, rEnd := 0, 0, 0, 0
+	for d := 0; d <= maxD; d++ {
+		for k := -d + kStart; k <= d-kEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+			switch {
+			case x > n:
+				kEnd += 2
+			case y > m:
+				kStart += 2
+			case odd:
+				r := offset + delta - k
+				if r >= 0 && r < len(reverse) && reverse[r] != -1 && x >= n-reverse[r] {
+					return x, y
+				}
+			}
+		}
+		for k := -d + rStart; k <= d-rEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && reverse[offset+k-1] < reverse[offset+k+1]) {
+				x = reverse[offset+k+1]
+			} else {
+				x = reverse[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			reverse[offset+k] = x
+			switch {
+			case x > n:
+				rEnd += 2
+			case y > m:
+				rStart += 2
+			case !odd:
+				f := offset + delta - k
+				if f >= 0 && f < len(forward) && forward[f] != -1 {
+					fx := forward[f]
+					if fx >= n-x {
+						return fx, fx - (delta - k)
+					}
+				}
+			}
+		}
+	}
+	// Not reached for valid input: the paths always meet within maxD steps
+	return n, 0
+}
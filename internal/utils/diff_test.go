@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		assert.Equal(t, "", UnifiedDiff("a.po", "a.po", []byte("a\nb\n"), []byte("a\nb\n")))
+	})
+
+	t.Run("Change In The Middle", func(t *testing.T) {
+		var oldLines, newLines []string
+		for i := 1; i <= 20; i++ {
+			oldLines = append(oldLines, fmt.Sprintf("line %d\n", i))
+			if i == 10 {
+				newLines = append(newLines, "changed\n")
+				continue
+			}
+			newLines = append(newLines, fmt.Sprintf("line %d\n", i))
+		}
+		diff := UnifiedDiff("a.po", "b.po", []byte(strings.Join(oldLines, "")), []byte(strings.Join(newLines, "")))
+		assert.Equal(t, `--- a.po
++++ b.po
+@@ -7,7 +7,7 @@
+ line 7
+ line 8
+ line 9
+-line 10
++changed
+ line 11
+ line 12
+ line 13
+`, diff)
+	})
+
+	t.Run("Separate Hunks", func(t *testing.T) {
+		old := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+		new := "A\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+		assert.Equal(t, `--- x
++++ x
+@@ -1,4 +1,4 @@
+-a
++A
+ b
+ c
+ d
+@@ -10,3 +10,4 @@
+ j
+ k
+ l
++m
+`, UnifiedDiff("x", "x", []byte(old), []byte(new)))
+	})
+
+	t.Run("New File", func(t *testing.T) {
+		assert.Equal(t, "--- /dev/null\n+++ new.pot\n@@ -0,0 +1,2 @@\n+a\n+b\n", UnifiedDiff("/dev/null", "new.pot", nil, []byte("a\nb\n")))
+	})
+
+	t.Run("Missing Newline", func(t *testing.T) {
+		assert.Equal(t, "--- x\n+++ x\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+a\n", UnifiedDiff("x", "x", []byte("a"), []byte("a\n")))
+	})
+
+	t.Run("Insertions And Deletions", func(t *testing.T) {
+		old := []string{"a", "b", "c", "a", "b", "b", "a"}
+		new := []string{"c", "b", "a", "b", "a", "c"}
+		lines := diffLines(old, new)
+		// Applying the script to a gives b, and it is as short as possible
+		var gotOld, gotNew []string
+		edits := 0
+		for _, line := range lines {
+			if line.kind != '+' {
+				gotOld = append(gotOld, line.text)
+			}
+			if line.kind != '-' {
+				gotNew = append(gotNew, line.text)
+			}
+			if line.kind != ' ' {
+				edits++
+			}
+		}
+		assert.Equal(t, old, gotOld)
+		assert.Equal(t, new, gotNew)
+		assert.Equal(t, 5, edits)
+	})
+}
+
+func TestCatalogDiffFile(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "fr.po")
+	require.NoError(t, os.WriteFile(path, latin1Po, 0644))
+
+	catalog, err := ParseCatalogFile(path)
+	require.NoError(t, err)
+
+	diff, err := catalog.DiffFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "", diff)
+
+	catalog.Set("", "Delete", "Supprimer l'élément sélectionné")
+	diff, err = catalog.DiffFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "-msgstr \"Supprimer l'élément\"\n+msgstr \"Supprimer l'élément sélectionné\"\n")
+
+	// The file is left untouched
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, latin1Po, content)
+
+	catalog.Set("", "Delete", "Удалить")
+	_, err = catalog.DiffFile(path)
+	assert.Error(t, err)
+
+	catalog.Set("", "Delete", "Supprimer")
+	diff, err = catalog.DiffFile(filepath.Join(tempDir, "missing.po"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(diff, "--- /dev/null\n"))
+}
+
+func TestDiffLinesShortest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, rng.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.Intn(3)))
+		}
+		return lines
+	}
+	for i := 0; i < 500; i++ {
+		a, b := randomLines(), randomLines()
+		lines := diffLines(a, b)
+
+		// The script turns a into b with as few edits as the longest common subsequence allows
+		old, new := []string{}, []string{}
+		edits := 0
+		for _, line := range lines {
+			if line.kind != '+' {
+				old = append(old, line.text)
+			}
+			if line.kind != '-' {
+				new = append(new, line.text)
+			}
+			if line.kind != ' ' {
+				edits++
+			}
+		}
+		assert.Equal(t, a, old, "%q -> %q", a, b)
+		assert.Equal(t, b, new, "%q -> %q", a, b)
+		assert.Equal(t, len(a)+len(b)-2*longestCommonSubsequence(a, b), edits, "%q -> %q", a, b)
+	}
+}
+
+func longestCommonSubsequence(a, b []string) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	return lengths[0][0]
+}
+
+func TestUnifiedDiffLargeInput(t *testing.T) {
+	// Every line of a large catalog changes, as when its line endings are rewritten
+	var old, new strings.Builder
+	for i := 0; i < 9000; i++ {
+		fmt.Fprintf(&old, "msgid \"Key %d\"\r\n", i)
+		fmt.Fprintf(&new, "msgid \"Key %d\"\n", i)
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	diff := UnifiedDiff("a.po", "b.po", []byte(old.String()), []byte(new.String()))
+	runtime.ReadMemStats(&after)
+
+	assert.Equal(t, 9000, strings.Count(diff, "\n-msgid"))
+	assert.Equal(t, 9000, strings.Count(diff, "\n+msgid"))
+	// The edit script needs memory proportional to the input, not to its square
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64<<20))
+}
@@ -0,0 +1,532 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Keyword describes a translation function and the 1-based positions of its arguments,
+// using the xgettext notation: "ngettext:1,2", "pgettext:1c,2"
+type Keyword struct {
+	Name     string `json:"name"`
+	Singular int    `json:"singular"`
+	Plural   int    `json:"plural,omitempty"`
+	Context  int    `json:"context,omitempty"`
+}
+
+// ExtractOptions configures how source files are scanned for translatable strings
+type ExtractOptions struct {
+	Keywords   []Keyword
+	Components []string
+	// CommentTag restricts extracted comments to those starting with the tag; empty keeps every adjacent comment
+	CommentTag string
+	Extensions []string
+}
+
+// ExtractedMessage is a translatable string found in the source tree
+type ExtractedMessage struct {
+	Context    string   `json:"context,omitempty"`
+	ID         string   `json:"msgid"`
+	PluralID   string   `json:"msgid_plural,omitempty"`
+	Comments   []string `json:"comments,omitempty"`
+	References []string `json:"references"`
+}
+
+// DefaultKeywordSpecs are the translation functions recognised when none are configured
+var DefaultKeywordSpecs = []string{
+	"t", "_", "__", "i18n._", "gettext",
+	"ngettext:1,2", "pgettext:1c,2", "npgettext:1c,2,3",
+	"_n:1,2", "_x:1,2c", "_nx:1,2,4c",
+}
+
+// DefaultComponents are the JSX components whose content is extracted when none are configured
+var DefaultComponents = []string{"Trans"}
+
+// DefaultSourceExtensions are the file extensions scanned when none are configured
+var DefaultSourceExtensions = []string{".js", ".jsx", ".ts", ".tsx", ".mjs", ".cjs"}
+
+// skippedSourceDirs are never descended into when walking a source tree
+var skippedSourceDirs = map[string]bool{
+	"node_modules": true,
+	".git":         true,
+	"dist":         true,
+	"build":        true,
+	".next":        true,
+	"coverage":     true,
+}
+
+// ParseKeyword parses an xgettext style keyword specification
+func ParseKeyword(spec string) (Keyword, error) {
+	spec = strings.TrimSpace(spec)
+	name, args, hasArgs := strings.Cut(spec, ":")
+	if name == "" {
+		return Keyword{}, fmt.Errorf("empty keyword in %q", spec)
+	}
+	keyword := Keyword{Name: name, Singular: 1}
+	if !hasArgs {
+		return keyword, nil
+	}
+
+	keyword.Singular = 0
+	for _, arg := range strings.Split(args, ",") {
+		arg = strings.TrimSpace(arg)
+		isContext := strings.HasSuffix(arg, "c")
+		position, err := strconv.Atoi(strings.TrimSuffix(arg, "c"))
+		if err != nil || position < 1 {
+			return Keyword{}, fmt.Errorf("invalid argument position %q in %q", arg, spec)
+		}
+		switch {
+		case isContext:
+			keyword.Context = position
+		case keyword.Singular == 0:
+			keyword.Singular = position
+		default:
+			keyword.Plural = position
+		}
+	}
+	if keyword.Singular == 0 {
+		return Keyword{}, fmt.Errorf("missing msgid position in %q", spec)
+	}
+	return keyword, nil
+}
+
+// ParseKeywords parses a list of keyword specifications
+func ParseKeywords(specs []string) ([]Keyword, error) {
+	keywords := make([]Keyword, 0, len(specs))
+	for _, spec := range specs {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		keyword, err := ParseKeyword(spec)
+		if err != nil {
+			return nil, err
+		}
+		keywords = append(keywords, keyword)
+	}
+	return keywords, nil
+}
+
+// DefaultExtractOptions returns the options used when nothing is configured
+func DefaultExtractOptions() ExtractOptions {
+	keywords, _ := ParseKeywords(DefaultKeywordSpecs)
+	return ExtractOptions{
+		Keywords:   keywords,
+		Components: DefaultComponents,
+		Extensions: DefaultSourceExtensions,
+	}
+}
+
+// SourceFile is a source file read from a project tree
+type SourceFile struct {
+	// Path is relative to the scanned root and uses forward slashes
+	Path    string
+	Content []byte
+}
+
+// ReadSourceFiles walks root and returns every file with one of the given extensions,
+// skipping dependency and build output directories
+func ReadSourceFiles(root string, extensions []string) ([]SourceFile, error) {
+	var files []SourceFile
+
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if filePath != root && skippedSourceDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !hasExtension(filePath, extensions) {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, filePath)
+		if err != nil {
+			rel = filePath
+		}
+		files = append(files, SourceFile{Path: filepath.ToSlash(rel), Content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// ExtractFromDirectory walks root and extracts translatable strings from every source file.
+// References are reported relative to root.
+func ExtractFromDirectory(root string, opts ExtractOptions) ([]ExtractedMessage, error) {
+	files, err := ReadSourceFiles(root, opts.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	return ExtractFromSourceFiles(files, opts), nil
+}
+
+// ExtractFromSourceFiles extracts and merges translatable strings from already read source files
+func ExtractFromSourceFiles(files []SourceFile, opts ExtractOptions) []ExtractedMessage {
+	var messages []ExtractedMessage
+	for _, file := range files {
+		messages = append(messages, ExtractFromSource(file.Path, file.Content, opts)...)
+	}
+	return MergeExtractedMessages(messages)
+}
+
+// MergeExtractedMessages combines messages sharing the same context and msgid
+func MergeExtractedMessages(messages []ExtractedMessage) []ExtractedMessage {
+	var merged []ExtractedMessage
+	index := make(map[string]int)
+	for _, msg := range messages {
+		key := EntryKey(msg.Context, msg.ID)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, msg)
+			continue
+		}
+		existing := &merged[i]
+		if existing.PluralID == "" {
+			existing.PluralID = msg.PluralID
+		}
+		existing.Comments = appendUnique(existing.Comments, msg.Comments...)
+		existing.References = appendUnique(existing.References, msg.References...)
+	}
+	return merged
+}
+
+// ExtractFromSource extracts translatable strings from a single JavaScript/TypeScript source
+func ExtractFromSource(path string, src []byte, opts ExtractOptions) []ExtractedMessage {
+	jsx := !strings.HasSuffix(strings.ToLower(path), ".ts")
+	tokens := lexJS(src, jsx)
+
+	e := &jsExtractor{path: path, src: src, tokens: tokens, opts: opts}
+	for i := range tokens {
+		switch tokens[i].kind {
+		case tokPunct:
+			if tokens[i].text == "(" {
+				e.extractCall(i)
+			}
+		case tokJSXOpen:
+			e.extractComponent(i)
+		}
+	}
+	return e.messages
+}
+
+// MergeIntoCatalog adds extracted messages to a catalog. Existing entries get their
+// references and extracted comments refreshed, new ones are added with an empty msgstr
+// before the obsolete entries.
+// It returns the msgids that were added.
+func MergeIntoCatalog(catalog *Catalog, messages []ExtractedMessage) []string {
+	var added []string
+	for _, msg := range messages {
+		entry := catalog.Lookup(msg.Context, msg.ID)
+		if entry == nil {
+			entry = &CatalogEntry{Context: msg.Context, ID: msg.ID, Str: []string{""}}
+			if msg.PluralID != "" {
+				entry.Str = []string{"", ""}
+			}
+			catalog.add(entry)
+			added = append(added, msg.ID)
+		}
+		if entry.PluralID == "" && msg.PluralID != "" {
+			entry.PluralID = msg.PluralID
+			for len(entry.Str) < 2 {
+				entry.Str = append(entry.Str, "")
+			}
+		}
+		entry.References = msg.References
+		entry.ExtractedComments = msg.Comments
+	}
+	return added
+}
+
+// NewTemplateCatalog creates an empty POT catalog with the standard xgettext headers
+func NewTemplateCatalog() *Catalog {
+	catalog := NewCatalog()
+	catalog.Header.Flags = []string{"fuzzy"}
+	catalog.SetHeaderValue("Project-Id-Version", "PACKAGE VERSION")
+	catalog.SetHeaderValue("POT-Creation-Date", time.Now().Format("2006-01-02 15:04-0700"))
+	catalog.SetHeaderValue("PO-Revision-Date", "YEAR-MO-DA HO:MI+ZONE")
+	catalog.SetHeaderValue("Language", "")
+	catalog.SetHeaderValue("MIME-Version", "1.0")
+	catalog.SetHeaderValue("Content-Type", "text/plain; charset=UTF-8")
+	catalog.SetHeaderValue("Content-Transfer-Encoding", "8bit")
+	return catalog
+}
+
+func hasExtension(path string, extensions []string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range extensions {
+		if strings.ToLower(e) == ext {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, existing := range list {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+type jsExtractor struct {
+	path     string
+	src      []byte
+	tokens   []jsToken
+	opts     ExtractOptions
+	messages []ExtractedMessage
+}
+
+// extractCall handles a "(" token that may follow a configured keyword
+func (e *jsExtractor) extractCall(open int) {
+	start, name := e.calleeName(open)
+	if name == "" {
+		return
+	}
+	keyword, ok := e.matchKeyword(name)
+	if !ok {
+		return
+	}
+
+	args := e.callArguments(open)
+	singular, ok := literalArgument(args, keyword.Singular)
+	if !ok {
+		return
+	}
+	msg := ExtractedMessage{ID: singular}
+	if keyword.Plural > 0 {
+		msg.PluralID, _ = literalArgument(args, keyword.Plural)
+	}
+	if keyword.Context > 0 {
+		if msg.Context, ok = literalArgument(args, keyword.Context); !ok {
+			return
+		}
+	}
+	e.add(msg, start)
+}
+
+// calleeName returns the dotted callee path ending right before the "(" token
+func (e *jsExtractor) calleeName(open int) (int, string) {
+	i := open - 1
+	if i < 0 || e.tokens[i].kind != tokIdent {
+		return 0, ""
+	}
+	parts := []string{e.tokens[i].text}
+	for i >= 2 && e.tokens[i-1].text == "." && e.tokens[i-1].kind == tokPunct && e.tokens[i-2].kind == tokIdent {
+		i -= 2
+		parts = append([]string{e.tokens[i].text}, parts...)
+	}
+	if i > 0 && e.tokens[i-1].kind == tokIdent && e.tokens[i-1].text == "function" {
+		return 0, ""
+	}
+	return i, strings.Join(parts, ".")
+}
+
+func (e *jsExtractor) matchKeyword(name string) (Keyword, bool) {
+	last := name[strings.LastIndex(name, ".")+1:]
+	for _, keyword := range e.opts.Keywords {
+		if keyword.Name == name || (!strings.Contains(keyword.Name, ".") && keyword.Name == last) {
+			return keyword, true
+		}
+	}
+	return Keyword{}, false
+}
+
+// callArguments splits the tokens of a call into its top-level arguments
+func (e *jsExtractor) callArguments(open int) [][]jsToken {
+	var args [][]jsToken
+	var current []jsToken
+	depth := 0
+	for i := open + 1; i < len(e.tokens); i++ {
+		tok := e.tokens[i]
+		if tok.kind == tokComment {
+			continue
+		}
+		if tok.kind == tokPunct {
+			switch tok.text {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				if depth == 0 {
+					if len(current) > 0 {
+						args = append(args, current)
+					}
+					return args
+				}
+				depth--
+			case ",":
+				if depth == 0 {
+					args = append(args, current)
+					current = nil
+					continue
+				}
+			}
+		}
+		current = append(current, tok)
+	}
+	return args
+}
+
+// literalArgument returns the value of a string literal argument, allowing "a" + "b" concatenation
+func literalArgument(args [][]jsToken, position int) (string, bool) {
+	if position < 1 || position > len(args) {
+		return "", false
+	}
+	arg := args[position-1]
+	if len(arg) == 0 {
+		return "", false
+	}
+	var b strings.Builder
+	for i, tok := range arg {
+		if i%2 == 0 {
+			if tok.kind != tokString {
+				return "", false
+			}
+			b.WriteString(tok.value)
+		} else if tok.kind != tokPunct || tok.text != "+" {
+			return "", false
+		}
+	}
+	if len(arg)%2 == 0 {
+		return "", false
+	}
+	return b.String(), true
+}
+
+// extractComponent handles an opening JSX tag of a configured component
+func (e *jsExtractor) extractComponent(open int) {
+	name := e.tokens[open].text
+	if !containsString(e.opts.Components, name) {
+		return
+	}
+
+	attrs := make(map[string]string)
+	end := -1
+	for i := open + 1; i < len(e.tokens); i++ {
+		tok := e.tokens[i]
+		if tok.elem == e.tokens[open].elem && (tok.kind == tokJSXOpenEnd || tok.kind == tokJSXSelfClose) {
+			end = i
+			break
+		}
+		if tok.kind == tokJSXAttr && tok.elem == e.tokens[open].elem && i+1 < len(e.tokens) && e.tokens[i+1].kind == tokString {
+			attrs[tok.text] = e.tokens[i+1].value
+		}
+	}
+	if end < 0 {
+		return
+	}
+
+	children := ""
+	if e.tokens[end].kind == tokJSXOpenEnd {
+		for i := end + 1; i < len(e.tokens); i++ {
+			if e.tokens[i].kind == tokJSXClose && e.tokens[i].elem == e.tokens[open].elem {
+				children = normalizeJSXText(string(e.src[e.tokens[end].end:e.tokens[i].start]))
+				break
+			}
+		}
+	}
+
+	msg := ExtractedMessage{
+		ID:       firstNonEmpty(attrs["id"], attrs["i18nKey"], children, attrs["message"]),
+		Context:  firstNonEmpty(attrs["context"], attrs["msgctxt"]),
+		PluralID: attrs["plural"],
+	}
+	if msg.ID == "" {
+		return
+	}
+	if comment := attrs["comment"]; comment != "" {
+		msg.Comments = append(msg.Comments, comment)
+	}
+	e.add(msg, open)
+}
+
+// add records a message found at the given token, attaching its preceding comments
+func (e *jsExtractor) add(msg ExtractedMessage, at int) {
+	msg.Comments = append(e.precedingComments(at), msg.Comments...)
+	msg.References = []string{fmt.Sprintf("%s:%d", e.path, e.tokens[at].line)}
+	e.messages = append(e.messages, msg)
+}
+
+// precedingComments collects the comment block directly above (or on the same line as) a token
+func (e *jsExtractor) precedingComments(at int) []string {
+	line := e.tokens[at].line
+	i := at - 1
+	for i >= 0 && e.tokens[i].kind != tokComment && (e.tokens[i].endLine == line || isCommentTrivia(e.tokens[i])) {
+		i--
+	}
+
+	var comments []string
+	next := line
+	for ; i >= 0 && e.tokens[i].kind == tokComment && e.tokens[i].endLine >= next-1; i-- {
+		comments = append([]string{e.tokens[i].value}, comments...)
+		next = e.tokens[i].line
+	}
+
+	if e.opts.CommentTag == "" {
+		return comments
+	}
+	var tagged []string
+	for i, c := range comments {
+		if strings.HasPrefix(c, e.opts.CommentTag) {
+			tagged = append(tagged, comments[i:]...)
+			break
+		}
+	}
+	return tagged
+}
+
+// isCommentTrivia reports tokens that may sit between a JSX comment and the element it describes
+func isCommentTrivia(tok jsToken) bool {
+	switch tok.kind {
+	case tokJSXText:
+		return strings.TrimSpace(tok.value) == ""
+	case tokPunct:
+		return tok.text == "{" || tok.text == "}"
+	}
+	return false
+}
+
+func normalizeJSXText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
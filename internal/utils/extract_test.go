@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findMessage(messages []ExtractedMessage, context, msgid string) *ExtractedMessage {
+	for i := range messages {
+		if messages[i].Context == context && messages[i].ID == msgid {
+			return &messages[i]
+		}
+	}
+	return nil
+}
+
+func TestParseKeyword(t *testing.T) {
+	keyword, err := ParseKeyword("t")
+	require.NoError(t, err)
+	assert.Equal(t, Keyword{Name: "t", Singular: 1}, keyword)
+
+	keyword, err = ParseKeyword("npgettext:1c,2,3")
+	require.NoError(t, err)
+	assert.Equal(t, Keyword{Name: "npgettext", Singular: 2, Plural: 3, Context: 1}, keyword)
+
+	_, err = ParseKeyword("bad:x")
+	assert.Error(t, err)
+
+	_, err = ParseKeyword("ctx:1c")
+	assert.Error(t, err)
+}
+
+func TestExtractFromSource(t *testing.T) {
+	src := `import { t } from 'i18n';
+
+// translators: shown in the page title
+const title = t('Dashboard');
+const greeting = i18n._("Hello, " + "world");
+const files = ngettext('%d file', '%d files', count);
+const open = pgettext('menu', 'Open');
+const dynamic = t(key);
+const path = url.replace(/t\('x'\)/g, '');
+const ratio = total / t('Not a regex');
+const tpl = __(` + "`Template`" + `);
+const mixed = t(` + "`Hi ${name}`" + `);
+
+function t(value) { return value; }
+
+export function Page() {
+  return (
+    <div title={t('Tooltip')}>
+      <p>Don't translate this paragraph</p>
+      {/* translators: footer link */}
+      <Trans>Read the <a href="/docs">docs</a></Trans>
+      <Trans id="welcome.message" context="home" comment="Greeting on home" />
+    </div>
+  );
+}
+`
+	messages := ExtractFromSource("src/page.tsx", []byte(src), DefaultExtractOptions())
+
+	t.Run("Function calls", func(t *testing.T) {
+		title := findMessage(messages, "", "Dashboard")
+		require.NotNil(t, title)
+		assert.Equal(t, []string{"src/page.tsx:4"}, title.References)
+		assert.Equal(t, []string{"translators: shown in the page title"}, title.Comments)
+
+		assert.NotNil(t, findMessage(messages, "", "Hello, world"))
+		assert.NotNil(t, findMessage(messages, "", "Not a regex"))
+		assert.NotNil(t, findMessage(messages, "", "Template"))
+		assert.NotNil(t, findMessage(messages, "", "Tooltip"))
+		assert.Nil(t, findMessage(messages, "", "x"))
+		assert.Nil(t, findMessage(messages, "", "Don't translate this paragraph"))
+	})
+
+	t.Run("Plural and context arguments", func(t *testing.T) {
+		files := findMessage(messages, "", "%d file")
+		require.NotNil(t, files)
+		assert.Equal(t, "%d files", files.PluralID)
+
+		assert.NotNil(t, findMessage(messages, "menu", "Open"))
+	})
+
+	t.Run("JSX components", func(t *testing.T) {
+		docs := findMessage(messages, "", `Read the <a href="/docs">docs</a>`)
+		require.NotNil(t, docs)
+		assert.Equal(t, []string{"translators: footer link"}, docs.Comments)
+
+		welcome := findMessage(messages, "home", "welcome.message")
+		require.NotNil(t, welcome)
+		assert.Equal(t, []string{"Greeting on home"}, welcome.Comments)
+	})
+
+	t.Run("Comment tag", func(t *testing.T) {
+		opts := DefaultExtractOptions()
+		opts.CommentTag = "NOTE:"
+		tagged := ExtractFromSource("a.js", []byte("// eslint-disable-line\n// NOTE: keep short\nt('Save')"), opts)
+		require.Len(t, tagged, 1)
+		assert.Equal(t, []string{"NOTE: keep short"}, tagged[0].Comments)
+	})
+
+	t.Run("Custom keywords", func(t *testing.T) {
+		opts := DefaultExtractOptions()
+		opts.Keywords, _ = ParseKeywords([]string{"translate:2"})
+		custom := ExtractFromSource("a.js", []byte("translate('ns', 'Custom'); t('Ignored')"), opts)
+		require.Len(t, custom, 1)
+		assert.Equal(t, "Custom", custom[0].ID)
+	})
+}
+
+func TestExtractFromDirectory(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "extract_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	files := map[string]string{
+		"src/a.ts":                  "t('Shared'); t('Only A');",
+		"src/b.jsx":                 "export default () => <Trans>Shared</Trans>;",
+		"src/readme.md":             "t('Not code')",
+		"node_modules/lib/index.js": "t('Dependency')",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	messages, err := ExtractFromDirectory(tempDir, DefaultExtractOptions())
+	require.NoError(t, err)
+	assert.Len(t, messages, 2)
+
+	shared := findMessage(messages, "", "Shared")
+	require.NotNil(t, shared)
+	assert.Equal(t, []string{"src/a.ts:1", "src/b.jsx:1"}, shared.References)
+	assert.Nil(t, findMessage(messages, "", "Dependency"))
+}
+
+func TestMergeIntoCatalog(t *testing.T) {
+	catalog, err := ParseCatalog([]byte(`msgid ""
+msgstr ""
+"Language: fr\n"
+
+#: old.js:1
+msgid "Existing"
+msgstr "Existant"
+
+#~ msgid "Removed"
+#~ msgstr "Supprimé"
+`))
+	require.NoError(t, err)
+
+	added := MergeIntoCatalog(catalog, []ExtractedMessage{
+		{ID: "Existing", References: []string{"new.js:3"}},
+		{ID: "%d item", PluralID: "%d items", Comments: []string{"count"}, References: []string{"new.js:4"}},
+	})
+	assert.Equal(t, []string{"%d item"}, added)
+
+	existing := catalog.Lookup("", "Existing")
+	assert.Equal(t, "Existant", existing.Translation())
+	assert.Equal(t, []string{"new.js:3"}, existing.References)
+
+	plural := catalog.Lookup("", "%d item")
+	require.NotNil(t, plural)
+	assert.Equal(t, []string{"", ""}, plural.Str)
+	assert.Contains(t, string(catalog.Marshal()), "#. count\n#: new.js:4\nmsgid \"%d item\"\nmsgid_plural \"%d items\"\nmsgstr[0] \"\"\nmsgstr[1] \"\"\n\n#~ msgid \"Removed\"")
+	// New entries are added before the obsolete ones
+	assert.True(t, catalog.Entries[len(catalog.Entries)-1].Obsolete)
+}
@@ -0,0 +1,266 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// indexMagic starts every index file and changes with the layout of the file
+const indexMagic = "i18n-mcp catalog index 1\n"
+
+// errIndexFormat is returned for index files that are truncated or of another layout
+var errIndexFormat = errors.New("invalid catalog index")
+
+// catalogIndexPath returns where the index of the catalog at path is kept: one file per
+// catalog in the index directory of its project in the data directory
+func catalogIndexPath(path string) (string, error) {
+	dir, err := ProjectDataPath(FindProjectRoot(path), "index", "")
+	if err != nil {
+		return "", err
+	}
+	key := sha256.Sum256([]byte(path))
+	return filepath.Join(dir, hex.EncodeToString(key[:8])+".idx"), nil
+}
+
+// readCatalogIndex returns the catalog stored in the index of the catalog at path when
+// the index was built from content with the given hash
+func readCatalogIndex(path string, sum [sha256.Size]byte) (*Catalog, error) {
+	indexPath, err := catalogIndexPath(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(indexPath)
+	if err != nil {
+		return nil, err
+	}
+	prefix := indexMagic + string(sum[:])
+	if !bytes.HasPrefix(data, []byte(prefix)) {
+		return nil, errIndexFormat
+	}
+	// The strings of the catalog are slices of one copy of the file
+	d := &indexDecoder{data: string(data[len(prefix):])}
+	catalog := d.catalog()
+	if d.err != nil {
+		return nil, d.err
+	}
+	return catalog, nil
+}
+
+// writeCatalogIndex stores the catalog parsed from content with the given hash in the
+// index of the catalog at path. The file is replaced atomically so concurrent readers
+// never see a partial index.
+func writeCatalogIndex(path string, sum [sha256.Size]byte, catalog *Catalog) error {
+	indexPath, err := catalogIndexPath(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(indexPath), 0755); err != nil {
+		return err
+	}
+	e := &indexEncoder{buf: append([]byte(indexMagic), sum[:]...)}
+	e.catalog(catalog)
+
+	tmp, err := os.CreateTemp(filepath.Dir(indexPath), ".idx-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(e.buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), indexPath)
+}
+
+// indexEncoder writes a catalog as length-prefixed fields
+type indexEncoder struct {
+	buf []byte
+}
+
+func (e *indexEncoder) uint(n int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(n))
+}
+
+func (e *indexEncoder) bool(b bool) {
+	if b {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *indexEncoder) string(s string) {
+	e.uint(len(s))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *indexEncoder) strings(list []string) {
+	e.bool(list != nil)
+	e.uint(len(list))
+	for _, s := range list {
+		e.string(s)
+	}
+}
+
+func (e *indexEncoder) catalog(c *Catalog) {
+	e.string(c.charset)
+	e.bool(c.crlf)
+	e.strings(c.trailing)
+	e.bool(c.Header != nil)
+	if c.Header != nil {
+		e.entry(c.Header)
+	}
+	e.uint(len(c.Entries))
+	for _, entry := range c.Entries {
+		e.entry(entry)
+	}
+}
+
+// entry writes the fields of an entry with its original lines. The rendering taken when
+// the entry was parsed is only stored when it differs from the original lines, which
+// is rare, so decoding never renders entries again.
+func (e *indexEncoder) entry(entry *CatalogEntry) {
+	e.strings(entry.TranslatorComments)
+	e.strings(entry.ExtractedComments)
+	e.strings(entry.References)
+	e.strings(entry.Flags)
+	e.string(entry.PreviousContext)
+	e.string(entry.PreviousID)
+	e.string(entry.PreviousPluralID)
+	e.string(entry.Context)
+	e.string(entry.ID)
+	e.string(entry.PluralID)
+	e.strings(entry.Str)
+	e.bool(entry.Obsolete)
+
+	e.bool(entry.raw != nil)
+	if entry.raw == nil {
+		return
+	}
+	raw := strings.Join(entry.raw, "\n")
+	e.string(raw)
+	e.bool(entry.rendered == raw)
+	if entry.rendered != raw {
+		e.string(entry.rendered)
+	}
+}
+
+// indexDecoder reads a catalog written by indexEncoder. The first error is kept and
+// makes every later read return zero values.
+type indexDecoder struct {
+	data string
+	err  error
+}
+
+// uint reads a length or count. Every string byte and list element takes at least one
+// byte, so larger values only come from a damaged file.
+func (d *indexDecoder) uint() int {
+	if d.err != nil {
+		return 0
+	}
+	var n uint64
+	for i := 0; i < len(d.data) && i < binary.MaxVarintLen64; i++ {
+		b := d.data[i]
+		n |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			d.data = d.data[i+1:]
+			if n > uint64(len(d.data)) {
+				break
+			}
+			return int(n)
+		}
+	}
+	d.err = errIndexFormat
+	return 0
+}
+
+func (d *indexDecoder) bool() bool {
+	if d.err != nil {
+		return false
+	}
+	if len(d.data) == 0 {
+		d.err = errIndexFormat
+		return false
+	}
+	b := d.data[0] == 1
+	d.data = d.data[1:]
+	return b
+}
+
+func (d *indexDecoder) string() string {
+	n := d.uint()
+	if d.err != nil {
+		return ""
+	}
+	s := d.data[:n]
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *indexDecoder) strings() []string {
+	present := d.bool()
+	n := d.uint()
+	if d.err != nil || !present {
+		return nil
+	}
+	list := make([]string, n)
+	for i := range list {
+		list[i] = d.string()
+	}
+	return list
+}
+
+func (d *indexDecoder) catalog() *Catalog {
+	c := &Catalog{charset: d.string(), crlf: d.bool(), trailing: d.strings()}
+	if d.bool() {
+		c.Header = d.entry()
+	}
+	n := d.uint()
+	if d.err != nil {
+		return nil
+	}
+	c.Entries = make([]*CatalogEntry, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		c.Entries = append(c.Entries, d.entry())
+	}
+	if d.err == nil && len(d.data) != 0 {
+		d.err = errIndexFormat
+	}
+	return c
+}
+
+func (d *indexDecoder) entry() *CatalogEntry {
+	entry := &CatalogEntry{
+		TranslatorComments: d.strings(),
+		ExtractedComments:  d.strings(),
+		References:         d.strings(),
+		Flags:              d.strings(),
+		PreviousContext:    d.string(),
+		PreviousID:         d.string(),
+		PreviousPluralID:   d.string(),
+		Context:            d.string(),
+		ID:                 d.string(),
+		PluralID:           d.string(),
+		Str:                d.strings(),
+		Obsolete:           d.bool(),
+	}
+	if !d.bool() {
+		return entry
+	}
+	raw := d.string()
+	entry.raw = strings.Split(raw, "\n")
+	entry.rendered = raw
+	if !d.bool() {
+		entry.rendered = d.string()
+	}
+	return entry
+}
@@ -0,0 +1,498 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type jsTokenKind int
+
+const (
+	tokIdent jsTokenKind = iota
+	tokNumber
+	tokString
+	tokTemplate
+	tokRegex
+	tokComment
+	tokPunct
+	tokJSXOpen
+	tokJSXAttr
+	tokJSXOpenEnd
+	tokJSXSelfClose
+	tokJSXClose
+	tokJSXText
+)
+
+// jsToken is a lexical token of a JavaScript/TypeScript/JSX source
+type jsToken struct {
+	kind    jsTokenKind
+	text    string
+	value   string
+	line    int
+	endLine int
+	start   int
+	end     int
+	// elem links the opening, closing and attribute tokens of one JSX element
+	elem int
+}
+
+type jsFrameKind int
+
+const (
+	frameJS jsFrameKind = iota
+	frameTemplate
+	frameJSXTag
+	frameJSXChildren
+)
+
+type jsFrame struct {
+	kind  jsFrameKind
+	depth int
+	elem  int
+}
+
+// jsLexer is a tolerant tokenizer that understands strings, comments, templates,
+// regex literals and JSX well enough to find translation calls
+type jsLexer struct {
+	src      []byte
+	pos      int
+	line     int
+	jsx      bool
+	frames   []jsFrame
+	tokens   []jsToken
+	nextElem int
+}
+
+func lexJS(src []byte, jsx bool) []jsToken {
+	l := &jsLexer{src: src, line: 1, jsx: jsx, frames: []jsFrame{{kind: frameJS}}}
+	for l.pos < len(l.src) {
+		switch l.top().kind {
+		case frameJS:
+			l.lexCode()
+		case frameTemplate:
+			l.lexTemplate()
+		case frameJSXTag:
+			l.lexJSXTag()
+		case frameJSXChildren:
+			l.lexJSXChildren()
+		}
+	}
+	return l.tokens
+}
+
+func (l *jsLexer) top() *jsFrame {
+	return &l.frames[len(l.frames)-1]
+}
+
+func (l *jsLexer) push(f jsFrame) {
+	l.frames = append(l.frames, f)
+}
+
+func (l *jsLexer) pop() {
+	if len(l.frames) > 1 {
+		l.frames = l.frames[:len(l.frames)-1]
+	}
+}
+
+func (l *jsLexer) peek(offset int) byte {
+	if l.pos+offset < len(l.src) {
+		return l.src[l.pos+offset]
+	}
+	return 0
+}
+
+// advance moves forward n bytes, keeping track of line numbers
+func (l *jsLexer) advance(n int) {
+	for i := 0; i < n && l.pos < len(l.src); i++ {
+		if l.src[l.pos] == '\n' {
+			l.line++
+		}
+		l.pos++
+	}
+}
+
+func (l *jsLexer) emit(kind jsTokenKind, start, line int, value string) {
+	l.tokens = append(l.tokens, jsToken{
+		kind:    kind,
+		text:    string(l.src[start:l.pos]),
+		value:   value,
+		line:    line,
+		endLine: l.line,
+		start:   start,
+		end:     l.pos,
+		elem:    l.top().elem,
+	})
+}
+
+func (l *jsLexer) lastToken() *jsToken {
+	for i := len(l.tokens) - 1; i >= 0; i-- {
+		if l.tokens[i].kind != tokComment {
+			return &l.tokens[i]
+		}
+	}
+	return nil
+}
+
+// expressionExpected reports whether the next token starts an expression, which
+// decides between division and regex literals and between "<" and JSX
+func (l *jsLexer) expressionExpected() bool {
+	last := l.lastToken()
+	if last == nil {
+		return true
+	}
+	switch last.kind {
+	case tokNumber, tokString, tokTemplate, tokRegex, tokJSXClose, tokJSXSelfClose:
+		return false
+	case tokIdent:
+		switch last.text {
+		case "return", "typeof", "instanceof", "in", "of", "new", "delete", "void", "throw", "case", "do", "else", "yield", "await", "default":
+			return true
+		}
+		return false
+	case tokPunct:
+		return last.text != ")" && last.text != "]"
+	}
+	return true
+}
+
+func (l *jsLexer) lexCode() {
+	c := l.src[l.pos]
+	start, line := l.pos, l.line
+
+	switch {
+	case c == '\n' || c == ' ' || c == '\t' || c == '\r':
+		l.advance(1)
+	case c == '/' && l.peek(1) == '/':
+		for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+			l.pos++
+		}
+		l.emit(tokComment, start, line, strings.TrimSpace(string(l.src[start+2:l.pos])))
+	case c == '/' && l.peek(1) == '*':
+		end := strings.Index(string(l.src[l.pos+2:]), "*/")
+		if end < 0 {
+			end = len(l.src) - l.pos - 2
+		}
+		l.advance(end + 4)
+		if l.pos > len(l.src) {
+			l.pos = len(l.src)
+		}
+		l.emit(tokComment, start, line, cleanBlockComment(string(l.src[start+2:start+2+end])))
+	case c == '\'' || c == '"':
+		value := l.readQuoted(c)
+		l.emit(tokString, start, line, value)
+	case c == '`':
+		l.lexTemplateStart()
+	case c == '/' && l.expressionExpected():
+		l.readRegex()
+		l.emit(tokRegex, start, line, "")
+	case c == '<' && l.jsx && l.expressionExpected() && (isIdentStart(l.peek(1)) || l.peek(1) == '>'):
+		l.advance(1)
+		nameStart := l.pos
+		for l.pos < len(l.src) && isJSXNameChar(l.src[l.pos]) {
+			l.pos++
+		}
+		l.nextElem++
+		l.push(jsFrame{kind: frameJSXTag, elem: l.nextElem})
+		l.tokens = append(l.tokens, jsToken{
+			kind: tokJSXOpen, text: string(l.src[nameStart:l.pos]), line: line, endLine: l.line,
+			start: start, end: l.pos, elem: l.nextElem,
+		})
+	case isIdentStart(c):
+		for l.pos < len(l.src) && isIdentPart(l.src[l.pos]) {
+			l.pos++
+		}
+		l.emit(tokIdent, start, line, "")
+	case c >= '0' && c <= '9':
+		for l.pos < len(l.src) && (isIdentPart(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		l.emit(tokNumber, start, line, "")
+	case c == '{':
+		l.top().depth++
+		l.advance(1)
+		l.emit(tokPunct, start, line, "")
+	case c == '}':
+		l.advance(1)
+		if l.top().depth == 0 && len(l.frames) > 1 {
+			l.pop()
+			l.emit(tokPunct, start, line, "")
+			return
+		}
+		l.top().depth--
+		l.emit(tokPunct, start, line, "")
+	default:
+		_, size := utf8.DecodeRune(l.src[l.pos:])
+		l.advance(size)
+		l.emit(tokPunct, start, line, "")
+	}
+}
+
+// readQuoted reads a '...' or "..." literal and returns its decoded value
+func (l *jsLexer) readQuoted(quote byte) string {
+	var b strings.Builder
+	l.advance(1)
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == quote {
+			l.advance(1)
+			break
+		}
+		if c == '\n' {
+			break
+		}
+		if c == '\\' && l.pos+1 < len(l.src) {
+			l.advance(1)
+			l.readEscape(&b)
+			continue
+		}
+		b.WriteByte(c)
+		l.advance(1)
+	}
+	return b.String()
+}
+
+func (l *jsLexer) readEscape(b *strings.Builder) {
+	c := l.src[l.pos]
+	switch c {
+	case 'n':
+		b.WriteByte('\n')
+	case 't':
+		b.WriteByte('\t')
+	case 'r':
+		b.WriteByte('\r')
+	case '\n':
+		// line continuation
+	case 'u':
+		if r, n := decodeUnicodeEscape(l.src[l.pos+1:]); n > 0 {
+			b.WriteRune(r)
+			l.advance(n + 1)
+			return
+		}
+		b.WriteByte(c)
+	default:
+		b.WriteByte(c)
+	}
+	l.advance(1)
+}
+
+// decodeUnicodeEscape decodes the part after "\u" of XXXX or {X...} escapes
+func decodeUnicodeEscape(s []byte) (rune, int) {
+	hex := ""
+	n := 0
+	if len(s) > 0 && s[0] == '{' {
+		end := strings.IndexByte(string(s), '}')
+		if end < 0 {
+			return 0, 0
+		}
+		hex, n = string(s[1:end]), end+1
+	} else if len(s) >= 4 {
+		hex, n = string(s[:4]), 4
+	} else {
+		return 0, 0
+	}
+	var r rune
+	for _, h := range hex {
+		switch {
+		case h >= '0' && h <= '9':
+			r = r*16 + h - '0'
+		case h >= 'a' && h <= 'f':
+			r = r*16 + h - 'a' + 10
+		case h >= 'A' && h <= 'F':
+			r = r*16 + h - 'A' + 10
+		default:
+			return 0, 0
+		}
+	}
+	return r, n
+}
+
+// lexTemplateStart reads a template literal; literals without substitutions are plain strings
+func (l *jsLexer) lexTemplateStart() {
+	start, line := l.pos, l.line
+	var b strings.Builder
+	for i := l.pos + 1; i < len(l.src); i++ {
+		c := l.src[i]
+		if c == '\\' {
+			i++
+			continue
+		}
+		if c == '$' && i+1 < len(l.src) && l.src[i+1] == '{' {
+			l.advance(1)
+			l.push(jsFrame{kind: frameTemplate})
+			l.emit(tokTemplate, start, line, "")
+			return
+		}
+		if c == '`' {
+			break
+		}
+	}
+
+	l.advance(1)
+	for l.pos < len(l.src) && l.src[l.pos] != '`' {
+		if l.src[l.pos] == '\\' && l.pos+1 < len(l.src) {
+			l.advance(1)
+			l.readEscape(&b)
+			continue
+		}
+		b.WriteByte(l.src[l.pos])
+		l.advance(1)
+	}
+	l.advance(1)
+	l.emit(tokString, start, line, b.String())
+}
+
+// lexTemplate continues a template literal with substitutions
+func (l *jsLexer) lexTemplate() {
+	start, line := l.pos, l.line
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\\':
+			l.advance(2)
+		case c == '`':
+			l.advance(1)
+			l.pop()
+			l.emit(tokTemplate, start, line, "")
+			return
+		case c == '$' && l.peek(1) == '{':
+			l.advance(2)
+			l.push(jsFrame{kind: frameJS})
+			return
+		default:
+			l.advance(1)
+		}
+	}
+}
+
+// readRegex skips a regular expression literal including its flags
+func (l *jsLexer) readRegex() {
+	l.advance(1)
+	inClass := false
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\\':
+			l.advance(2)
+			continue
+		case c == '\n':
+			return
+		case c == '[':
+			inClass = true
+		case c == ']':
+			inClass = false
+		case c == '/' && !inClass:
+			l.advance(1)
+			for l.pos < len(l.src) && isIdentPart(l.src[l.pos]) {
+				l.pos++
+			}
+			return
+		}
+		l.advance(1)
+	}
+}
+
+func (l *jsLexer) lexJSXTag() {
+	c := l.src[l.pos]
+	start, line := l.pos, l.line
+	elem := l.top().elem
+
+	switch {
+	case c == '\n' || c == ' ' || c == '\t' || c == '\r':
+		l.advance(1)
+	case c == '/' && l.peek(1) == '>':
+		l.advance(2)
+		l.emit(tokJSXSelfClose, start, line, "")
+		l.pop()
+	case c == '>':
+		l.advance(1)
+		l.emit(tokJSXOpenEnd, start, line, "")
+		l.pop()
+		l.push(jsFrame{kind: frameJSXChildren, elem: elem})
+	case c == '\'' || c == '"':
+		l.advance(1)
+		for l.pos < len(l.src) && l.src[l.pos] != c {
+			l.advance(1)
+		}
+		l.advance(1)
+		value := ""
+		if l.pos-1 > start {
+			value = string(l.src[start+1 : l.pos-1])
+		}
+		l.emit(tokString, start, line, value)
+	case c == '{':
+		l.advance(1)
+		l.emit(tokPunct, start, line, "")
+		l.push(jsFrame{kind: frameJS, elem: elem})
+	case isIdentStart(c):
+		for l.pos < len(l.src) && isJSXNameChar(l.src[l.pos]) {
+			l.pos++
+		}
+		l.emit(tokJSXAttr, start, line, "")
+	default:
+		l.advance(1)
+	}
+}
+
+func (l *jsLexer) lexJSXChildren() {
+	c := l.src[l.pos]
+	start, line := l.pos, l.line
+	elem := l.top().elem
+
+	switch {
+	case c == '<' && l.peek(1) == '/':
+		l.advance(2)
+		nameStart := l.pos
+		for l.pos < len(l.src) && l.src[l.pos] != '>' {
+			l.advance(1)
+		}
+		name := strings.TrimSpace(string(l.src[nameStart:l.pos]))
+		l.advance(1)
+		l.tokens = append(l.tokens, jsToken{
+			kind: tokJSXClose, text: name, line: line, endLine: l.line, start: start, end: l.pos, elem: elem,
+		})
+		l.pop()
+	case c == '<':
+		l.advance(1)
+		nameStart := l.pos
+		for l.pos < len(l.src) && isJSXNameChar(l.src[l.pos]) {
+			l.pos++
+		}
+		l.nextElem++
+		l.push(jsFrame{kind: frameJSXTag, elem: l.nextElem})
+		l.tokens = append(l.tokens, jsToken{
+			kind: tokJSXOpen, text: string(l.src[nameStart:l.pos]), line: line, endLine: l.line,
+			start: start, end: l.pos, elem: l.nextElem,
+		})
+	case c == '{':
+		l.advance(1)
+		l.emit(tokPunct, start, line, "")
+		l.push(jsFrame{kind: frameJS, elem: elem})
+	default:
+		for l.pos < len(l.src) && l.src[l.pos] != '<' && l.src[l.pos] != '{' {
+			l.advance(1)
+		}
+		l.emit(tokJSXText, start, line, string(l.src[start:l.pos]))
+	}
+}
+
+// cleanBlockComment strips the leading "*" decoration of block comment lines
+func cleanBlockComment(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+	}
+	return strings.TrimSpace(strings.Join(lines, " "))
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 || unicode.IsLetter(rune(c))
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+func isJSXNameChar(c byte) bool {
+	return isIdentPart(c) || c == '-' || c == '.' || c == ':'
+}
@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// localePattern matches gettext locale names and BCP 47 tags such as fr, fr_FR,
+// zh-Hant-TW, sr@latin and de_DE.UTF-8
+var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(?:[_-][a-zA-Z0-9]{2,8})*(?:\.[\w-]+)?(?:@[a-zA-Z]+)?$`)
+
+// localeModifiers maps gettext @modifiers to the BCP 47 subtag they stand for
+var localeModifiers = map[string]string{
+	"latin":      "Latn",
+	"cyrillic":   "Cyrl",
+	"arabic":     "Arab",
+	"devanagari": "Deva",
+	"valencia":   "valencia",
+}
+
+// extraLanguages are ISO 639 codes accepted when inferring a language from a path
+// in addition to the languages of the plural rules table
+var extraLanguages = []string{
+	"an", "ba", "br", "ce", "co", "cv", "fj", "gn", "gv", "ht", "io", "kl", "kw", "la",
+	"li", "mg", "mi", "nv", "os", "qu", "sa", "sc", "sd", "se", "sg", "sm", "sn", "st",
+	"tg", "tn", "to", "ts", "tw", "ty", "ve", "vo", "za",
+}
+
+var knownLanguages = func() map[string]bool {
+	known := make(map[string]bool)
+	for tag := range pluralRules {
+		primary, _, _ := strings.Cut(tag, "-")
+		known[primary] = true
+	}
+	for _, language := range extraLanguages {
+		known[language] = true
+	}
+	return known
+}()
+
+// NormalizeLanguageTag converts a gettext locale name or language tag to BCP 47 form:
+// zh_hk becomes zh-HK, sr@latin becomes sr-Latn and de_DE.UTF-8 becomes de-DE.
+// Values that do not look like a language tag are returned trimmed but unchanged.
+func NormalizeLanguageTag(tag string) string {
+	tag = strings.TrimSpace(tag)
+	if !localePattern.MatchString(tag) {
+		return tag
+	}
+
+	tag, modifier, _ := strings.Cut(tag, "@")
+	tag, _, _ = strings.Cut(tag, ".")
+
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '_' || r == '-' })
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 && isAlpha(subtag), len(subtag) == 3 && !isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+
+	if extra, ok := localeModifiers[strings.ToLower(modifier)]; ok {
+		if len(extra) == 4 {
+			// A script goes right after the language subtag
+			subtags = append(subtags[:1], append([]string{extra}, subtags[1:]...)...)
+		} else {
+			subtags = append(subtags, extra)
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// PrimaryLanguage returns the language subtag of a tag, such as "pt" for "pt_BR"
+func PrimaryLanguage(tag string) string {
+	primary, _, _ := strings.Cut(NormalizeLanguageTag(tag), "-")
+	return primary
+}
+
+// LanguageFromPath infers the language of a .po file from common layouts: the file
+// name (fr.po, fr_FR.po, app.fr.po), the gettext directory layout
+// (fr/LC_MESSAGES/app.po) and locale directories (locale/fr_FR/app.po).
+// It returns an empty string when no known language is found.
+func LanguageFromPath(path string) string {
+	language, _ := languageInPath(path)
+	return language
+}
+
+// CatalogDomain returns the path of a .po file with its language replaced by a
+// placeholder, so the catalogs of one domain in different languages share a domain:
+// locale/fr/LC_MESSAGES/app.po and locale/es/LC_MESSAGES/app.po both have the domain
+// locale/{language}/LC_MESSAGES/app.po. Paths without a language are their own domain.
+func CatalogDomain(path string) string {
+	_, domain := languageInPath(path)
+	return domain
+}
+
+// DomainPath returns the path of the catalog of a domain (see CatalogDomain) in the
+// language written as locale
+func DomainPath(domain, locale string) string {
+	return strings.ReplaceAll(domain, LanguagePlaceholder, locale)
+}
+
+// GettextLocale writes a language tag the way gettext names locales: pt-BR becomes
+// pt_BR and sr-Latn-RS becomes sr_RS@latin
+func GettextLocale(tag string) string {
+	subtags := strings.Split(NormalizeLanguageTag(tag), "-")
+	locale, modifier := []string{subtags[0]}, ""
+	for _, subtag := range subtags[1:] {
+		if name := localeModifier(subtag); name != "" && modifier == "" {
+			modifier = "@" + name
+			continue
+		}
+		locale = append(locale, subtag)
+	}
+	return strings.Join(locale, "_") + modifier
+}
+
+// localeModifier returns the gettext @modifier standing for a BCP 47 subtag
+func localeModifier(subtag string) string {
+	for name, value := range localeModifiers {
+		if value == subtag {
+			return name
+		}
+	}
+	return ""
+}
+
+// LanguagePlaceholder stands for the language in the domain of a catalog
+const LanguagePlaceholder = "{language}"
+
+// localeRoots are directory names holding one directory per language, such as locales/de
+var localeRoots = map[string]bool{"locale": true, "locales": true, "i18n": true, "po": true}
+
+// languageInPath returns the language found in a path and the path with that
+// language replaced by a placeholder. The language is taken from the file name, from
+// the directory holding LC_MESSAGES, or from a directory right under a locale root
+// such as locales/de; other directories such as src/ts are never languages.
+func languageInPath(path string) (string, string) {
+	dir, ext := filepath.Dir(path), filepath.Ext(path)
+	base := strings.TrimSuffix(filepath.Base(path), ext)
+
+	type candidate struct{ value, domain string }
+	candidates := []candidate{{base, filepath.Join(dir, LanguagePlaceholder+ext)}}
+	if idx := strings.LastIndex(base, "."); idx >= 0 {
+		candidates = append(candidates, candidate{base[idx+1:], filepath.Join(dir, base[:idx+1]+LanguagePlaceholder+ext)})
+	}
+
+	// Walk up a few directories looking for the language directory of a gettext
+	// category or a locale root
+	rest := filepath.Base(path)
+	for i := 0; i < 4 && dir != filepath.Dir(dir); i++ {
+		name, parent := filepath.Base(dir), filepath.Dir(dir)
+		underCategory := strings.HasPrefix(rest, "LC_MESSAGES"+string(filepath.Separator))
+		if name != "LC_MESSAGES" && (underCategory || localeRoots[strings.ToLower(filepath.Base(parent))]) {
+			candidates = append(candidates, candidate{name, filepath.Join(parent, LanguagePlaceholder, rest)})
+		}
+		rest = filepath.Join(name, rest)
+		dir = parent
+	}
+
+	for _, c := range candidates {
+		if localePattern.MatchString(c.value) && knownLanguages[PrimaryLanguage(c.value)] {
+			return NormalizeLanguageTag(c.value), c.domain
+		}
+	}
+	return "", path
+}
+
+// LanguagesMatch reports whether two tags name the same language. Tags match when their
+// primary languages are equal and they do not name different regions or scripts,
+// so "fr" matches "fr-FR" but "pt-BR" does not match "pt-PT".
+func LanguagesMatch(a, b string) bool {
+	a, b = NormalizeLanguageTag(a), NormalizeLanguageTag(b)
+	if a == b {
+		return true
+	}
+	aSubtags, bSubtags := strings.Split(a, "-"), strings.Split(b, "-")
+	if aSubtags[0] != bSubtags[0] {
+		return false
+	}
+	return len(aSubtags) == 1 || len(bSubtags) == 1
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLanguageTag(t *testing.T) {
+	tests := map[string]string{
+		"fr":             "fr",
+		"zh_HK":          "zh-HK",
+		"zh-hk":          "zh-HK",
+		"ZH_hk":          "zh-HK",
+		"zh_hant_tw":     "zh-Hant-TW",
+		"sr@latin":       "sr-Latn",
+		"sr_RS@latin":    "sr-Latn-RS",
+		"ca@valencia":    "ca-valencia",
+		"de_DE.UTF-8":    "de-DE",
+		"es_419":         "es-419",
+		" pt_BR ":        "pt-BR",
+		"":               "",
+		"not a language": "not a language",
+	}
+	for input, want := range tests {
+		assert.Equal(t, want, NormalizeLanguageTag(input), input)
+	}
+}
+
+func TestLanguageFromPath(t *testing.T) {
+	tests := map[string]string{
+		"locales/fr.po":                       "fr",
+		"locales/pt_BR.po":                    "pt-BR",
+		"i18n/app.de.po":                      "de",
+		"locale/fr/LC_MESSAGES/app.po":        "fr",
+		"locale/fr_FR/app.po":                 "fr-FR",
+		"po/sr@latin.po":                      "sr-Latn",
+		"translations/messages.po":            "",
+		"src/app/translations/messages.po":    "",
+		"locale/xx/LC_MESSAGES/messages.po":   "",
+		"web/locale/zh_Hant/LC_MESSAGES/a.po": "zh-Hant",
+		"locales/de/common/messages.po":       "de",
+		"app/de/LC_MESSAGES/messages.po":      "de",
+		// Directories named like a language outside a locale layout are not languages
+		"src/ts/locale/messages.po":   "",
+		"my/project/messages.po":      "",
+		"it/locales/messages.po":      "",
+		"lib/is/la/sc/translation.po": "",
+	}
+	for path, want := range tests {
+		assert.Equal(t, want, LanguageFromPath(path), path)
+	}
+}
+
+func TestCatalogDomain(t *testing.T) {
+	tests := map[string]string{
+		"locales/fr.po":                "locales/{language}.po",
+		"i18n/app.de.po":               "i18n/app.{language}.po",
+		"locale/fr/LC_MESSAGES/app.po": "locale/{language}/LC_MESSAGES/app.po",
+		"locale/fr_FR/app.po":          "locale/{language}/app.po",
+		"translations/messages.po":     "translations/messages.po",
+		"src/ts/locale/messages.po":    "src/ts/locale/messages.po",
+	}
+	for path, want := range tests {
+		assert.Equal(t, filepath.FromSlash(want), CatalogDomain(filepath.FromSlash(path)), path)
+	}
+
+	assert.Equal(t, CatalogDomain("locale/pt_BR/LC_MESSAGES/app.po"), CatalogDomain("locale/es/LC_MESSAGES/app.po"))
+	assert.NotEqual(t, CatalogDomain("locale/es/LC_MESSAGES/admin.po"), CatalogDomain("locale/es/LC_MESSAGES/app.po"))
+}
+
+func TestGettextLocale(t *testing.T) {
+	tests := map[string]string{
+		"fr":          "fr",
+		"pt-BR":       "pt_BR",
+		"zh-Hant-TW":  "zh_Hant_TW",
+		"sr-Latn":     "sr@latin",
+		"sr-Latn-RS":  "sr_RS@latin",
+		"ca-valencia": "ca@valencia",
+		"es-419":      "es_419",
+		"de_DE.UTF-8": "de_DE",
+	}
+	for tag, want := range tests {
+		assert.Equal(t, want, GettextLocale(tag), tag)
+	}
+	assert.Equal(t, filepath.FromSlash("locale/pt_BR/LC_MESSAGES/app.po"), DomainPath(CatalogDomain(filepath.FromSlash("locale/fr/LC_MESSAGES/app.po")), "pt_BR"))
+}
+
+func TestLanguagesMatch(t *testing.T) {
+	assert.True(t, LanguagesMatch("fr", "fr_FR"))
+	assert.True(t, LanguagesMatch("zh_HK", "zh-hk"))
+	assert.False(t, LanguagesMatch("pt-BR", "pt-PT"))
+	assert.False(t, LanguagesMatch("fr", "de"))
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"os"
+	"testing"
+)
+
+// TestMain keeps the catalog indexes written by the tests out of the user config directory
+func TestMain(m *testing.M) {
+	dataDir, err := os.MkdirTemp("", "i18n_mcp_data")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv(DataDirEnv, dataDir)
+	code := m.Run()
+	os.RemoveAll(dataDir)
+	os.Exit(code)
+}
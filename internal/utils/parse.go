@@ -1,15 +1,14 @@
 package utils
 
 import (
-	"os"
-
 	"github.com/leonelquinteros/gotext"
 )
 
-// ParsePoFile parses a .po file and returns a gotext.Po object
+// ParsePoFile parses a .po file and returns a gotext.Po object. Files in another
+// charset than UTF-8 are decoded using the charset of their Content-Type header.
 func ParsePoFile(path string) (gotext.Po, error) {
 	po := gotext.NewPo()
-	fileContent, err := os.ReadFile(path)
+	fileContent, _, err := ReadPoFile(path)
 	if err != nil {
 		return gotext.Po{}, err
 	}
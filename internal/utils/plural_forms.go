@@ -0,0 +1,280 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/leonelquinteros/gotext/plurals"
+)
+
+// PluralForms is a parsed Plural-Forms header
+type PluralForms struct {
+	NPlurals   int    `json:"nplurals"`
+	Expression string `json:"plural"`
+}
+
+// pluralRules maps languages to their gettext plural forms, derived from the CLDR plural rules
+// for integer counts. Keys are lowercase BCP 47 tags; regional variants only appear when they
+// differ from the base language.
+var pluralRules = map[string]PluralForms{
+	// One form
+	"ja": {1, "0"}, "ko": {1, "0"}, "zh": {1, "0"}, "vi": {1, "0"}, "th": {1, "0"},
+	"id": {1, "0"}, "ms": {1, "0"}, "lo": {1, "0"}, "my": {1, "0"}, "km": {1, "0"},
+	"bo": {1, "0"}, "dz": {1, "0"}, "jv": {1, "0"}, "ig": {1, "0"}, "yo": {1, "0"},
+	"su": {1, "0"}, "wo": {1, "0"}, "sah": {1, "0"}, "ii": {1, "0"}, "tt": {1, "0"},
+
+	// one / other, singular only for 1
+	"en": {2, "(n != 1)"}, "de": {2, "(n != 1)"}, "nl": {2, "(n != 1)"}, "sv": {2, "(n != 1)"},
+	"da": {2, "(n != 1)"}, "no": {2, "(n != 1)"}, "nb": {2, "(n != 1)"}, "nn": {2, "(n != 1)"},
+	"fi": {2, "(n != 1)"}, "et": {2, "(n != 1)"}, "it": {2, "(n != 1)"}, "es": {2, "(n != 1)"},
+	"ca": {2, "(n != 1)"}, "gl": {2, "(n != 1)"}, "eu": {2, "(n != 1)"}, "el": {2, "(n != 1)"},
+	"hu": {2, "(n != 1)"}, "bg": {2, "(n != 1)"}, "tr": {2, "(n != 1)"}, "az": {2, "(n != 1)"},
+	"ka": {2, "(n != 1)"}, "kk": {2, "(n != 1)"}, "ky": {2, "(n != 1)"}, "uz": {2, "(n != 1)"},
+	"sq": {2, "(n != 1)"}, "af": {2, "(n != 1)"}, "sw": {2, "(n != 1)"}, "ta": {2, "(n != 1)"},
+	"te": {2, "(n != 1)"}, "ml": {2, "(n != 1)"}, "kn": {2, "(n != 1)"}, "mr": {2, "(n != 1)"},
+	"ur": {2, "(n != 1)"}, "ne": {2, "(n != 1)"}, "ps": {2, "(n != 1)"}, "eo": {2, "(n != 1)"},
+	"fo": {2, "(n != 1)"}, "fy": {2, "(n != 1)"}, "lb": {2, "(n != 1)"}, "mn": {2, "(n != 1)"},
+	"or": {2, "(n != 1)"}, "xh": {2, "(n != 1)"}, "ku": {2, "(n != 1)"}, "ckb": {2, "(n != 1)"},
+	"tk": {2, "(n != 1)"}, "ug": {2, "(n != 1)"}, "so": {2, "(n != 1)"}, "rm": {2, "(n != 1)"},
+	"ast": {2, "(n != 1)"}, "pt-pt": {2, "(n != 1)"}, "yi": {2, "(n != 1)"}, "ha": {2, "(n != 1)"},
+
+	// one / other, singular for 0 and 1
+	"fr": {2, "(n > 1)"}, "pt": {2, "(n > 1)"}, "hi": {2, "(n > 1)"}, "bn": {2, "(n > 1)"},
+	"fa": {2, "(n > 1)"}, "hy": {2, "(n > 1)"}, "am": {2, "(n > 1)"}, "ln": {2, "(n > 1)"},
+	"oc": {2, "(n > 1)"}, "ti": {2, "(n > 1)"}, "wa": {2, "(n > 1)"}, "zu": {2, "(n > 1)"},
+	"pa": {2, "(n > 1)"}, "si": {2, "(n > 1)"}, "as": {2, "(n > 1)"}, "gu": {2, "(n > 1)"},
+	"kab": {2, "(n > 1)"},
+
+	// Other two-form rules
+	"is":  {2, "(n%10 != 1 || n%100 == 11)"},
+	"mk":  {2, "(n%10 == 1 && n%100 != 11 ? 0 : 1)"},
+	"fil": {2, "(n == 1 || n == 2 || n == 3 || (n%10 != 4 && n%10 != 6 && n%10 != 9) ? 0 : 1)"},
+	"tl":  {2, "(n == 1 || n == 2 || n == 3 || (n%10 != 4 && n%10 != 6 && n%10 != 9) ? 0 : 1)"},
+
+	// East Slavic and Serbo-Croatian: one / few / many
+	"ru": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"uk": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"be": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"sr": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"hr": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"bs": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+
+	// Other three-form rules
+	"pl": {3, "(n == 1 ? 0 : n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"cs": {3, "(n == 1 ? 0 : n >= 2 && n <= 4 ? 1 : 2)"},
+	"sk": {3, "(n == 1 ? 0 : n >= 2 && n <= 4 ? 1 : 2)"},
+	"lt": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n%10 >= 2 && (n%100 < 10 || n%100 >= 20) ? 1 : 2)"},
+	"lv": {3, "(n%10 == 1 && n%100 != 11 ? 0 : n != 0 ? 1 : 2)"},
+	"ro": {3, "(n == 1 ? 0 : n == 0 || (n%100 > 0 && n%100 < 20) ? 1 : 2)"},
+	"he": {3, "(n == 1 ? 0 : n == 2 ? 1 : 2)"},
+
+	// Four or more forms
+	"sl": {4, "(n%100 == 1 ? 0 : n%100 == 2 ? 1 : n%100 == 3 || n%100 == 4 ? 2 : 3)"},
+	"gd": {4, "(n == 1 || n == 11 ? 0 : n == 2 || n == 12 ? 1 : n > 2 && n < 20 ? 2 : 3)"},
+	"mt": {4, "(n == 1 ? 0 : n == 0 || (n%100 > 1 && n%100 < 11) ? 1 : n%100 > 10 && n%100 < 20 ? 2 : 3)"},
+	"ga": {5, "(n == 1 ? 0 : n == 2 ? 1 : n >= 3 && n <= 6 ? 2 : n >= 7 && n <= 10 ? 3 : 4)"},
+	"ar": {6, "(n == 0 ? 0 : n == 1 ? 1 : n == 2 ? 2 : n%100 >= 3 && n%100 <= 10 ? 3 : n%100 >= 11 ? 4 : 5)"},
+	"cy": {6, "(n == 0 ? 0 : n == 1 ? 1 : n == 2 ? 2 : n == 3 ? 3 : n == 6 ? 4 : 5)"},
+}
+
+// PluralSampleNumbers are the counts used to show which numbers map to which plural form
+var PluralSampleNumbers = func() []int {
+	var samples []int
+	for n := 0; n <= 200; n++ {
+		samples = append(samples, n)
+	}
+	return append(samples, 1000, 1001, 1002, 1005, 1011, 1021, 10000, 100000, 1000000)
+}()
+
+// LookupPluralForms returns the plural forms of a language from the embedded table.
+// The full tag is tried first (pt-PT), then the primary language subtag (pt).
+func LookupPluralForms(language string) (PluralForms, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+	if tag == "" {
+		return PluralForms{}, false
+	}
+	if rule, ok := pluralRules[tag]; ok {
+		return rule, true
+	}
+	primary, _, _ := strings.Cut(tag, "-")
+	rule, ok := pluralRules[primary]
+	return rule, ok
+}
+
+// ParsePluralForms parses a "nplurals=N; plural=EXPR;" header value
+func ParsePluralForms(value string) (PluralForms, error) {
+	var forms PluralForms
+	hasNPlurals := false
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(val))
+			if err != nil || n < 1 {
+				return forms, fmt.Errorf("invalid nplurals %q", strings.TrimSpace(val))
+			}
+			forms.NPlurals = n
+			hasNPlurals = true
+		case "plural":
+			forms.Expression = strings.TrimSpace(val)
+		}
+	}
+	if !hasNPlurals {
+		return forms, fmt.Errorf("missing nplurals in %q", value)
+	}
+	if forms.Expression == "" {
+		return forms, fmt.Errorf("missing plural expression in %q", value)
+	}
+	if err := checkPluralSyntax(forms.Expression); err != nil {
+		return forms, err
+	}
+	if _, err := forms.Evaluate(0); err != nil {
+		return forms, err
+	}
+	return forms, nil
+}
+
+// checkPluralSyntax rejects characters and unbalanced parentheses the lenient
+// expression compiler would otherwise accept
+func checkPluralSyntax(expression string) error {
+	depth := 0
+	for _, r := range expression {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("invalid plural expression %q: unbalanced parentheses", expression)
+			}
+		case r == 'n' || (r >= '0' && r <= '9') || strings.ContainsRune(" \t?:!=<>&|%+-*/", r):
+		default:
+			return fmt.Errorf("invalid plural expression %q: unexpected character %q", expression, r)
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("invalid plural expression %q: unbalanced parentheses", expression)
+	}
+	return nil
+}
+
+// String returns the Plural-Forms header value
+func (p PluralForms) String() string {
+	return fmt.Sprintf("nplurals=%d; plural=%s;", p.NPlurals, p.Expression)
+}
+
+// Evaluate returns the plural form index selected for n
+func (p PluralForms) Evaluate(n int) (int, error) {
+	eval, err := p.evaluator()
+	if err != nil {
+		return 0, err
+	}
+	return eval(n)
+}
+
+// Samples maps each form index to the sample numbers that select it. Numbers selecting an
+// index outside 0..nplurals-1 are reported under that index so broken headers are visible.
+func (p PluralForms) Samples(numbers []int) (map[int][]int, error) {
+	eval, err := p.evaluator()
+	if err != nil {
+		return nil, err
+	}
+	samples := make(map[int][]int)
+	for _, n := range numbers {
+		index, err := eval(n)
+		if err != nil {
+			return nil, err
+		}
+		samples[index] = append(samples[index], n)
+	}
+	return samples, nil
+}
+
+// Equivalent reports whether two plural forms select the same index for every sample number
+func (p PluralForms) Equivalent(other PluralForms) bool {
+	if p.NPlurals != other.NPlurals {
+		return false
+	}
+	a, errA := p.Samples(PluralSampleNumbers)
+	b, errB := other.Samples(PluralSampleNumbers)
+	if errA != nil || errB != nil || len(a) != len(b) {
+		return false
+	}
+	for index, numbers := range a {
+		if !slices.Equal(numbers, b[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// FormMapping returns, for each form index of p, the form index of other selecting the
+// same sample numbers. It reports false unless both have the same forms in another order.
+func (p PluralForms) FormMapping(other PluralForms) ([]int, bool) {
+	if p.NPlurals != other.NPlurals {
+		return nil, false
+	}
+	a, errA := p.evaluator()
+	b, errB := other.evaluator()
+	if errA != nil || errB != nil {
+		return nil, false
+	}
+	mapping := make([]int, p.NPlurals)
+	for i := range mapping {
+		mapping[i] = -1
+	}
+	used := make([]bool, other.NPlurals)
+	for _, n := range PluralSampleNumbers {
+		from, errA := a(n)
+		to, errB := b(n)
+		if errA != nil || errB != nil || from < 0 || from >= p.NPlurals || to < 0 || to >= other.NPlurals {
+			return nil, false
+		}
+		switch {
+		case mapping[from] == to:
+		case mapping[from] == -1 && !used[to]:
+			mapping[from], used[to] = to, true
+		default:
+			return nil, false
+		}
+	}
+	if slices.Contains(mapping, -1) {
+		return nil, false
+	}
+	return mapping, true
+}
+
+// evaluator compiles the plural expression. The compiler panics on some malformed
+// expressions, so evaluation errors are recovered and returned.
+func (p PluralForms) evaluator() (func(n int) (int, error), error) {
+	expr, err := compilePlural(p.Expression)
+	if err != nil {
+		return nil, err
+	}
+	return func(n int) (index int, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("invalid plural expression %q", p.Expression)
+			}
+		}()
+		return expr.Eval(uint32(n)), nil
+	}, nil
+}
+
+func compilePlural(expression string) (expr plurals.Expression, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid plural expression %q", expression)
+		}
+	}()
+	expr, err = plurals.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plural expression %q: %w", expression, err)
+	}
+	return expr, nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePluralForms(t *testing.T) {
+	forms, err := ParsePluralForms("nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);")
+	require.NoError(t, err)
+	assert.Equal(t, 3, forms.NPlurals)
+
+	for n, want := range map[int]int{1: 0, 21: 0, 11: 2, 2: 1, 24: 1, 12: 2, 5: 2, 100: 2} {
+		index, err := forms.Evaluate(n)
+		require.NoError(t, err)
+		assert.Equal(t, want, index, "n=%d", n)
+	}
+
+	_, err = ParsePluralForms("plural=(n != 1);")
+	assert.Error(t, err)
+	_, err = ParsePluralForms("nplurals=two; plural=(n != 1);")
+	assert.Error(t, err)
+	_, err = ParsePluralForms("nplurals=2;")
+	assert.Error(t, err)
+}
+
+func TestLookupPluralForms(t *testing.T) {
+	ru, ok := LookupPluralForms("ru_RU")
+	require.True(t, ok)
+	assert.Equal(t, 3, ru.NPlurals)
+
+	pt, ok := LookupPluralForms("pt_BR")
+	require.True(t, ok)
+	assert.Equal(t, "(n > 1)", pt.Expression)
+
+	ptPT, ok := LookupPluralForms("pt-PT")
+	require.True(t, ok)
+	assert.Equal(t, "(n != 1)", ptPT.Expression)
+
+	_, ok = LookupPluralForms("xx")
+	assert.False(t, ok)
+	_, ok = LookupPluralForms("")
+	assert.False(t, ok)
+}
+
+func TestPluralFormsTableCompiles(t *testing.T) {
+	for language, forms := range pluralRules {
+		samples, err := forms.Samples(PluralSampleNumbers)
+		require.NoError(t, err, language)
+		for index := range samples {
+			assert.Less(t, index, forms.NPlurals, "%s selects form %d", language, index)
+		}
+		assert.Len(t, samples, forms.NPlurals, "%s does not use every form", language)
+	}
+}
+
+func TestPluralFormsFormMapping(t *testing.T) {
+	// Latvian with the zero form first, as some tools write it, and the gettext order
+	zeroFirst := PluralForms{NPlurals: 3, Expression: "(n%10 == 0 || (n%100 >= 11 && n%100 <= 19) ? 0 : n%10 == 1 && n%100 != 11 ? 1 : 2)"}
+	lv, ok := LookupPluralForms("lv")
+	require.True(t, ok)
+	assert.Equal(t, "(n%10 == 1 && n%100 != 11 ? 0 : n != 0 ? 1 : 2)", lv.Expression)
+
+	// The zero-first rule puts 10 and 20 with 0, gettext puts them with the other numbers
+	_, ok = zeroFirst.FormMapping(lv)
+	assert.False(t, ok)
+
+	reversed := PluralForms{NPlurals: 2, Expression: "(n == 1)"}
+	mapping, ok := reversed.FormMapping(PluralForms{NPlurals: 2, Expression: "(n != 1)"})
+	require.True(t, ok)
+	assert.Equal(t, []int{1, 0}, mapping)
+
+	_, ok = reversed.FormMapping(PluralForms{NPlurals: 3, Expression: "(n == 1 ? 0 : n == 2 ? 1 : 2)"})
+	assert.False(t, ok)
+}
+
+func TestPluralFormsEquivalent(t *testing.T) {
+	en := PluralForms{NPlurals: 2, Expression: "(n != 1)"}
+	assert.True(t, en.Equivalent(PluralForms{NPlurals: 2, Expression: "n!=1"}))
+	assert.False(t, en.Equivalent(PluralForms{NPlurals: 2, Expression: "(n > 1)"}))
+	assert.False(t, en.Equivalent(PluralForms{NPlurals: 3, Expression: "(n != 1)"}))
+	assert.Equal(t, "nplurals=2; plural=(n != 1);", en.String())
+}
+
+func TestParsePluralFormsInvalidExpression(t *testing.T) {
+	for _, header := range []string{"nplurals=2; plural=(n != 1;", "nplurals=2; plural=n ==;", "nplurals=2; plural=foo;"} {
+		_, err := ParsePluralForms(header)
+		assert.Error(t, err, header)
+	}
+}
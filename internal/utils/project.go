@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ConfigDirName is the directory in the project root holding project-wide settings
+// such as length constraints and the glossary
+const ConfigDirName = ".i18n-mcp"
+
+// DataDirEnv overrides the directory holding data kept between runs, such as the journals
+const DataDirEnv = "I18N_MCP_DATA_DIR"
+
+// projectMarkers are files or directories that identify the root of a project
+var projectMarkers = []string{ConfigDirName, ".git", "package.json", "go.mod"}
+
+// errFound stops a directory walk early
+var errFound = errors.New("found")
+
+// FindProjectRoot walks up from path until it finds a directory containing a project
+// marker (.i18n-mcp, .git, package.json or go.mod). When none is found the directory
+// of path itself is returned.
+func FindProjectRoot(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	start := abs
+	if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+		start = filepath.Dir(abs)
+	}
+
+	for dir := start; ; dir = filepath.Dir(dir) {
+		for _, marker := range projectMarkers {
+			if _, err := os.Stat(filepath.Join(dir, marker)); err == nil {
+				return dir
+			}
+		}
+		if filepath.Dir(dir) == dir {
+			return start
+		}
+	}
+}
+
+// DataDir returns the directory for data kept between runs: $I18N_MCP_DATA_DIR,
+// or i18n-mcp in the user config directory
+func DataDir() (string, error) {
+	if dir := os.Getenv(DataDirEnv); dir != "" {
+		return dir, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "i18n-mcp"), nil
+}
+
+// ProjectDataPath returns the path of a file kept for the project at root in the data
+// directory, such as its journal: <data dir>/<kind>/<hash of root><ext>
+func ProjectDataPath(root, kind, ext string) (string, error) {
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	dataDir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	key := sha256.Sum256([]byte(root))
+	return filepath.Join(dataDir, kind, hex.EncodeToString(key[:8])+ext), nil
+}
+
+// FindFileBySuffix searches root for a file whose path ends with the given relative path.
+// Leading "./" and "../" segments are ignored. It returns an empty string when nothing matches.
+func FindFileBySuffix(root, relPath string) (string, error) {
+	suffix := filepath.ToSlash(relPath)
+	for strings.HasPrefix(suffix, "./") || strings.HasPrefix(suffix, "../") {
+		suffix = suffix[strings.Index(suffix, "/")+1:]
+	}
+	if suffix == "" {
+		return "", nil
+	}
+
+	found := ""
+	err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if filePath != root && skippedSourceDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		slashed := filepath.ToSlash(filePath)
+		if slashed == suffix || strings.HasSuffix(slashed, "/"+suffix) {
+			found = filePath
+			return errFound
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errFound) {
+		return "", err
+	}
+	return found, nil
+}
+
+// LoadProjectConfig decodes the JSON file name from the config directory of the project
+// into v. A missing file leaves v unchanged and is not an error.
+func LoadProjectConfig(projectRoot, name string, v any) error {
+	content, err := os.ReadFile(filepath.Join(projectRoot, ConfigDirName, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(content, v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// SaveProjectConfig writes v as indented JSON to the config directory of the project
+func SaveProjectConfig(projectRoot, name string, v any) error {
+	dir := filepath.Join(projectRoot, ConfigDirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	content, err := marshalProjectConfig(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, name), content, 0644)
+}
+
+// DiffProjectConfig returns the unified diff SaveProjectConfig would apply to the
+// config file, without writing it
+func DiffProjectConfig(projectRoot, name string, v any) (string, error) {
+	content, err := marshalProjectConfig(v)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(projectRoot, ConfigDirName, name)
+	oldName := path
+	existing, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		oldName = "/dev/null"
+	} else if err != nil {
+		return "", err
+	}
+	return UnifiedDiff(oldName, path, existing, content), nil
+}
+
+func marshalProjectConfig(v any) ([]byte, error) {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// MatchGlob reports whether a slash-separated path matches a glob pattern. Besides the
+// path.Match syntax, "**" matches any number of directories, and patterns without a
+// slash are matched against the base name.
+func MatchGlob(pattern, name string) bool {
+	name = filepath.ToSlash(name)
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	if !strings.Contains(pattern, "**") {
+		ok, _ := path.Match(pattern, name)
+		return ok
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case c == '*':
+			expr.WriteString("[^/]*")
+		case c == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	ok, _ := regexp.MatchString(expr.String(), name)
+	return ok
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindProjectRoot(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "project_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	nested := filepath.Join(tempDir, "app", "locale", "fr", "LC_MESSAGES")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+	poFile := filepath.Join(nested, "app.po")
+	require.NoError(t, os.WriteFile(poFile, []byte(""), 0644))
+
+	// Without markers the directory of the file is used
+	assert.Equal(t, nested, FindProjectRoot(poFile))
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app", "package.json"), []byte("{}"), 0644))
+	assert.Equal(t, filepath.Join(tempDir, "app"), FindProjectRoot(poFile))
+	assert.Equal(t, filepath.Join(tempDir, "app"), FindProjectRoot(nested))
+}
+
+func TestDataDir(t *testing.T) {
+	t.Setenv(DataDirEnv, "/tmp/i18n-data")
+	dir, err := DataDir()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/i18n-data", dir)
+
+	path, err := ProjectDataPath("/work/app", "journal", ".jsonl")
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/i18n-data/journal", filepath.Dir(path))
+	assert.Equal(t, ".jsonl", filepath.Ext(path))
+}
+
+func TestFindFileBySuffix(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "project_suffix_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	target := filepath.Join(tempDir, "web", "src", "pages", "Home.tsx")
+	require.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+	require.NoError(t, os.WriteFile(target, []byte(""), 0644))
+
+	found, err := FindFileBySuffix(tempDir, "../src/pages/Home.tsx")
+	require.NoError(t, err)
+	assert.Equal(t, target, found)
+
+	found, err = FindFileBySuffix(tempDir, "pages/Missing.tsx")
+	require.NoError(t, err)
+	assert.Equal(t, "", found)
+}
+
+func TestMatchGlob(t *testing.T) {
+	assert.True(t, MatchGlob("*.tsx", "src/components/Button.tsx"))
+	assert.True(t, MatchGlob("src/components/*", "src/components/Button.tsx"))
+	assert.False(t, MatchGlob("src/components/*", "src/components/forms/Input.tsx"))
+	assert.True(t, MatchGlob("src/**/*.tsx", "src/components/forms/Input.tsx"))
+	assert.True(t, MatchGlob("**/buttons/*", "app/ui/buttons/Save.jsx"))
+	assert.False(t, MatchGlob("src/**/*.tsx", "lib/Input.tsx"))
+}
+
+func TestProjectConfig(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "project_config_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// A missing file is not an error
+	var config map[string]int
+	require.NoError(t, LoadProjectConfig(tempDir, "settings.json", &config))
+	assert.Nil(t, config)
+
+	require.NoError(t, SaveProjectConfig(tempDir, "settings.json", map[string]int{"limit": 3}))
+	require.NoError(t, LoadProjectConfig(tempDir, "settings.json", &config))
+	assert.Equal(t, map[string]int{"limit": 3}, config)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, ConfigDirName, "broken.json"), []byte("{"), 0644))
+	assert.Error(t, LoadProjectConfig(tempDir, "broken.json", &config))
+}
@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -10,6 +12,13 @@ import (
 type PoFileInfo struct {
 	Path     string `json:"path"`
 	Language string `json:"language"`
+
+	// HeaderLanguage is the Language header as written in the file
+	HeaderLanguage string `json:"header_language,omitempty"`
+	// PathLanguage is the language inferred from the file name or directories
+	PathLanguage string `json:"path_language,omitempty"`
+	// LanguageMismatch is set when the header and the path name different languages
+	LanguageMismatch bool `json:"language_mismatch,omitempty"`
 }
 
 // ScanPoFiles scans all .po files in the given path and returns a list of file paths
@@ -36,36 +45,78 @@ func ScanPoFiles(path string) ([]string, error) {
 	return poFiles, nil
 }
 
-// ScanPoFilesWithInfo scans all .po files in the given path and returns detailed information
+// ScanPoFilesWithInfo scans all .po files in the given path and returns detailed information.
+// Only the header entry of each file is read.
 func ScanPoFilesWithInfo(path string) ([]PoFileInfo, error) {
-	var poFilesInfo []PoFileInfo
+	poFiles, err := ScanPoFiles(path)
+	if err != nil {
+		return nil, err
+	}
 
-	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+	var poFilesInfo []PoFileInfo
+	for _, filePath := range poFiles {
+		// Try to parse the PO file to get language info
+		headerLanguage := ""
+		if header, err := readHeader(filePath); err == nil {
+			headerLanguage = strings.TrimSpace(header.Language())
 		}
+		poFilesInfo = append(poFilesInfo, NewPoFileInfo(filePath, headerLanguage))
+	}
 
-		// Check if the file has .po extension
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(filePath), ".po") {
-			// Try to parse the PO file to get language info
-			po, err := ParsePoFile(filePath)
-			language := ""
-			if err == nil {
-				language = po.Language
-			}
-
-			poFilesInfo = append(poFilesInfo, PoFileInfo{
-				Path:     filePath,
-				Language: language,
-			})
-		}
+	return poFilesInfo, nil
+}
 
-		return nil
-	})
+// NewPoFileInfo builds the information of a PO file from its Language header. The language
+// is the normalized header, or the language inferred from the path when the header is empty.
+func NewPoFileInfo(path, headerLanguage string) PoFileInfo {
+	info := PoFileInfo{
+		Path:           path,
+		Language:       NormalizeLanguageTag(headerLanguage),
+		HeaderLanguage: headerLanguage,
+		PathLanguage:   LanguageFromPath(path),
+	}
+	if info.Language == "" {
+		info.Language = info.PathLanguage
+	}
+	info.LanguageMismatch = headerLanguage != "" && info.PathLanguage != "" && !LanguagesMatch(headerLanguage, info.PathLanguage)
+	return info
+}
+
+// headerReadSize is how much of a file readHeader reads first, enough for any usual header
+const headerReadSize = 64 * 1024
 
+// readHeader parses the first entry of a .po file, which holds the header, without
+// reading the rest of the file
+func readHeader(path string) (*Catalog, error) {
+	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
 
-	return poFilesInfo, nil
+	content, err := io.ReadAll(io.LimitReader(file, headerReadSize))
+	if err != nil {
+		return nil, err
+	}
+	// Blank lines may also separate comments before the first entry
+	for start := 0; ; {
+		blank := blankLine.FindIndex(content[start:])
+		if blank == nil {
+			break
+		}
+		catalog, err := ParseCatalog(content[:start+blank[0]])
+		if err == nil && (catalog.Header != nil || len(catalog.Entries) > 0) {
+			return catalog, nil
+		}
+		start += blank[1] - 1
+	}
+
+	rest, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalog(append(content, rest...))
 }
+
+// blankLine matches the blank line ending the first entry of a file
+var blankLine = regexp.MustCompile(`\n[ \t\r]*\n`)
@@ -258,3 +258,52 @@ msgstr "Hola"`), 0644)
 		assert.Contains(t, poFiles[0].Path, "invalid.po")
 	})
 }
+
+func TestScanPoFilesWithInfo_LanguageInference(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "po-test-language")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		// Header written in gettext form
+		"zh_HK.po": "msgid \"\"\nmsgstr \"\"\n\"Language: zh_hk\\n\"\n",
+		// Header missing, language taken from the directory layout
+		"locale/fr/LC_MESSAGES/app.po": "msgid \"Hello\"\nmsgstr \"Bonjour\"\n",
+		// Header naming another language than the file
+		"de.po": "msgid \"\"\nmsgstr \"\"\n\"Language: fr\\n\"\n",
+		// Directory named like a language that is not part of a locale layout
+		"src/ts/locale/messages.po": "msgid \"\"\nmsgstr \"\"\n\"Language: de\\n\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	poFiles, err := ScanPoFilesWithInfo(tempDir)
+	require.NoError(t, err)
+	require.Len(t, poFiles, 4)
+
+	infos := make(map[string]PoFileInfo)
+	for _, info := range poFiles {
+		rel, err := filepath.Rel(tempDir, info.Path)
+		require.NoError(t, err)
+		infos[filepath.ToSlash(rel)] = info
+	}
+
+	assert.Equal(t, "zh-HK", infos["zh_HK.po"].Language)
+	assert.Equal(t, "zh_hk", infos["zh_HK.po"].HeaderLanguage)
+	assert.False(t, infos["zh_HK.po"].LanguageMismatch)
+
+	assert.Equal(t, "fr", infos["locale/fr/LC_MESSAGES/app.po"].Language)
+	assert.Equal(t, "", infos["locale/fr/LC_MESSAGES/app.po"].HeaderLanguage)
+	assert.Equal(t, "fr", infos["locale/fr/LC_MESSAGES/app.po"].PathLanguage)
+
+	assert.Equal(t, "fr", infos["de.po"].Language)
+	assert.Equal(t, "de", infos["de.po"].PathLanguage)
+	assert.True(t, infos["de.po"].LanguageMismatch)
+
+	assert.Equal(t, "de", infos["src/ts/locale/messages.po"].Language)
+	assert.Equal(t, "", infos["src/ts/locale/messages.po"].PathLanguage)
+	assert.False(t, infos["src/ts/locale/messages.po"].LanguageMismatch)
+}